@@ -0,0 +1,76 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Access Context Manager API has no vendored client, operations are
+// polled through sendRequest and handled as generic JSON objects.
+type AccessContextManagerOperationWaiter struct {
+	Config *Config
+	Op     map[string]interface{}
+}
+
+func (w *AccessContextManagerOperationWaiter) RefreshFunc() resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		name, _ := w.Op["name"].(string)
+		op, err := sendRequest(w.Config, "GET", "https://accesscontextmanager.googleapis.com/v1beta/"+name, nil)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		done, _ := op["done"].(bool)
+		log.Printf("[DEBUG] Got %v while polling for operation %s's 'done' status", done, name)
+
+		return op, fmt.Sprint(done), nil
+	}
+}
+
+func (w *AccessContextManagerOperationWaiter) Conf() *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending: []string{"false"},
+		Target:  []string{"true"},
+		Refresh: w.RefreshFunc(),
+	}
+}
+
+func accessContextManagerOperationWaitTime(config *Config, op map[string]interface{}, activity string, timeoutMin int) error {
+	return accessContextManagerOperationWaitTimeWithResponse(config, op, nil, activity, timeoutMin)
+}
+
+// accessContextManagerOperationWaitTimeWithResponse waits for op and, if
+// response is not nil, stores the response of the finished operation in it.
+func accessContextManagerOperationWaitTimeWithResponse(config *Config, op map[string]interface{}, response *map[string]interface{}, activity string, timeoutMin int) error {
+	if done, _ := op["done"].(bool); !done {
+		w := &AccessContextManagerOperationWaiter{
+			Config: config,
+			Op:     op,
+		}
+
+		state := w.Conf()
+		state.Delay = 10 * time.Second
+		state.Timeout = time.Duration(timeoutMin) * time.Minute
+		state.MinTimeout = 2 * time.Second
+		opRaw, err := state.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for %s: %s", activity, err)
+		}
+
+		op = opRaw.(map[string]interface{})
+	}
+
+	if opErr, ok := op["error"].(map[string]interface{}); ok {
+		return fmt.Errorf("Error code %v, message: %s", opErr["code"], opErr["message"])
+	}
+
+	if response != nil {
+		*response, _ = op["response"].(map[string]interface{})
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+)
+
+// waitForInstancesStable waits for no instances of the instance group
+// manager returned by f to be created, for wait_for_instances_status STABLE.
+func waitForInstancesStable(f getInstanceManagerFunc, d *schema.ResourceData, meta interface{}) error {
+	conf := resource.StateChangeConf{
+		Pending: []string{"creating", "error"},
+		Target:  []string{"created"},
+		Refresh: waitForInstancesRefreshFunc(f, d, meta),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+	_, err := conf.WaitForState()
+	return err
+}
+
+// waitForInstancesUpdated waits for every instance of the instance group
+// manager returned by f to be healthy for the autohealing health check and to
+// run the template of its version, for wait_for_instances_status UPDATED. It
+// only runs after the manager was created or updated, as unhealthy instances
+// would otherwise block every refresh.
+func waitForInstancesUpdated(f getInstanceManagerFunc, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	if !d.Get("wait_for_instances").(bool) || d.Get("wait_for_instances_status").(string) != "UPDATED" {
+		return nil
+	}
+
+	var pending []string
+	conf := resource.StateChangeConf{
+		Pending: []string{"updating"},
+		Target:  []string{"updated"},
+		Refresh: func() (interface{}, string, error) {
+			m, err := f(d, meta)
+			if err != nil {
+				return nil, "", err
+			}
+			if m == nil {
+				return nil, "", fmt.Errorf("Instance group manager %q not found", d.Id())
+			}
+			instances, err := listManagedInstances(meta.(*Config), m.SelfLink)
+			if err != nil {
+				return nil, "", err
+			}
+			pending = pendingManagedInstances(m, instances)
+			if len(pending) > 0 {
+				return m, "updating", nil
+			}
+			return m, "updated", nil
+		},
+		Timeout: timeout,
+	}
+	if _, err := conf.WaitForState(); err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok && len(pending) > 0 {
+			return fmt.Errorf("Error waiting for instances of instance group manager %q to be updated: %s\n\n%s", d.Id(), err, strings.Join(pending, "\n"))
+		}
+		return fmt.Errorf("Error waiting for instances of instance group manager %q to be updated: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// listManagedInstances lists the managed instances of the instance group
+// manager at url. The health of the instances isn't in the compute client
+// yet, they're read as raw JSON from the beta API.
+func listManagedInstances(config *Config, url string) ([]interface{}, error) {
+	url = strings.Replace(url, "/compute/v1/", "/compute/beta/", 1) + "/listManagedInstances"
+
+	instances := make([]interface{}, 0)
+	pageToken := ""
+	for {
+		pageUrl := url
+		if pageToken != "" {
+			var err error
+			pageUrl, err = addQueryParams(url, map[string]string{"pageToken": pageToken})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := sendRequest(config, "POST", pageUrl, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing managed instances: %s", err)
+		}
+
+		items, _ := res["managedInstances"].([]interface{})
+		instances = append(instances, items...)
+
+		pageToken, _ = res["nextPageToken"].(string)
+		if pageToken == "" {
+			return instances, nil
+		}
+	}
+}
+
+// pendingManagedInstances describes the instances of the manager that aren't
+// updated yet, and the versions that aren't fully rolled out. The result is
+// empty once the group is updated.
+func pendingManagedInstances(manager *computeBeta.InstanceGroupManager, instances []interface{}) []string {
+	pending := make([]string, 0)
+
+	templates := make(map[string]int)
+	for _, version := range manager.Versions {
+		templates[ConvertSelfLinkToV1(version.InstanceTemplate)] = 0
+	}
+	if len(manager.Versions) == 0 && manager.InstanceTemplate != "" {
+		templates[ConvertSelfLinkToV1(manager.InstanceTemplate)] = 0
+	}
+
+	healthChecks := make([]string, 0, len(manager.AutoHealingPolicies))
+	for _, policy := range manager.AutoHealingPolicies {
+		healthChecks = append(healthChecks, ConvertSelfLinkToV1(policy.HealthCheck))
+	}
+
+	for _, raw := range instances {
+		instance := raw.(map[string]interface{})
+		name := GetResourceNameFromSelfLink(fmt.Sprint(instance["instance"]))
+
+		template := ""
+		if version, ok := instance["version"].(map[string]interface{}); ok {
+			template = ConvertSelfLinkToV1(fmt.Sprint(version["instanceTemplate"]))
+		}
+		_, upToDate := templates[template]
+		if upToDate {
+			templates[template]++
+		}
+
+		health := managedInstanceHealth(instance, healthChecks)
+		currentAction := fmt.Sprint(instance["currentAction"])
+		if currentAction != "NONE" || !upToDate || health != "HEALTHY" {
+			pending = append(pending, fmt.Sprintf("%s: status %v, current action %s, health %s, template %s",
+				name, instance["instanceStatus"], currentAction, health, GetResourceNameFromSelfLink(template)))
+		}
+	}
+	sort.Strings(pending)
+
+	if int64(len(instances)) != manager.TargetSize {
+		pending = append(pending, fmt.Sprintf("%d of %d instances created", len(instances), manager.TargetSize))
+	}
+	for _, version := range manager.Versions {
+		if version.TargetSize == nil {
+			continue
+		}
+		count := templates[ConvertSelfLinkToV1(version.InstanceTemplate)]
+		if int64(count) != version.TargetSize.Calculated {
+			pending = append(pending, fmt.Sprintf("version %q: %d of %d instances rolled out", version.Name, count, version.TargetSize.Calculated))
+		}
+	}
+
+	return pending
+}
+
+// managedInstanceHealth returns HEALTHY if the instance is healthy for all
+// the health checks, or the first other health state reported for them.
+func managedInstanceHealth(instance map[string]interface{}, healthChecks []string) string {
+	states := make(map[string]string)
+	healths, _ := instance["instanceHealth"].([]interface{})
+	for _, raw := range healths {
+		health := raw.(map[string]interface{})
+		states[ConvertSelfLinkToV1(fmt.Sprint(health["healthCheck"]))] = fmt.Sprint(health["detailedHealthState"])
+	}
+
+	for _, healthCheck := range healthChecks {
+		state, ok := states[healthCheck]
+		if !ok {
+			return "UNKNOWN"
+		}
+		if state != "HEALTHY" {
+			return state
+		}
+	}
+	return "HEALTHY"
+}
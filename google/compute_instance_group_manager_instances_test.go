@@ -0,0 +1,112 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	computeBeta "google.golang.org/api/compute/v0.beta"
+)
+
+func TestPendingManagedInstances(t *testing.T) {
+	const (
+		prefix = "https://www.googleapis.com/compute/beta/projects/my-project/"
+		stable = prefix + "global/instanceTemplates/stable"
+		canary = prefix + "global/instanceTemplates/canary"
+		hc     = prefix + "global/healthChecks/hc"
+	)
+
+	manager := &computeBeta.InstanceGroupManager{
+		TargetSize: 2,
+		Versions: []*computeBeta.InstanceGroupManagerVersion{
+			{Name: "stable", InstanceTemplate: stable},
+			{Name: "canary", InstanceTemplate: canary, TargetSize: &computeBeta.FixedOrPercent{Fixed: 1, Calculated: 1}},
+		},
+		AutoHealingPolicies: []*computeBeta.InstanceGroupManagerAutoHealingPolicy{
+			{HealthCheck: hc},
+		},
+	}
+
+	instance := func(name, template, action, health string) map[string]interface{} {
+		i := map[string]interface{}{
+			"instance":       prefix + "zones/us-central1-a/instances/" + name,
+			"instanceStatus": "RUNNING",
+			"currentAction":  action,
+			"version": map[string]interface{}{
+				"instanceTemplate": template,
+			},
+		}
+		if health != "" {
+			i["instanceHealth"] = []interface{}{
+				map[string]interface{}{
+					"healthCheck":         hc,
+					"detailedHealthState": health,
+				},
+			}
+		}
+		return i
+	}
+
+	cases := map[string]struct {
+		Instances []interface{}
+		Expected  []string
+	}{
+		"updated": {
+			Instances: []interface{}{
+				instance("a", stable, "NONE", "HEALTHY"),
+				instance("b", canary, "NONE", "HEALTHY"),
+			},
+			Expected: []string{},
+		},
+		"unhealthy": {
+			Instances: []interface{}{
+				instance("a", stable, "NONE", "HEALTHY"),
+				instance("b", canary, "NONE", "UNHEALTHY"),
+			},
+			Expected: []string{
+				"b: status RUNNING, current action NONE, health UNHEALTHY, template canary",
+			},
+		},
+		"no health state": {
+			Instances: []interface{}{
+				instance("a", stable, "NONE", "HEALTHY"),
+				instance("b", canary, "VERIFYING", ""),
+			},
+			Expected: []string{
+				"b: status RUNNING, current action VERIFYING, health UNKNOWN, template canary",
+			},
+		},
+		"not rolled out": {
+			Instances: []interface{}{
+				instance("a", stable, "NONE", "HEALTHY"),
+				instance("b", stable, "NONE", "HEALTHY"),
+			},
+			Expected: []string{
+				`version "canary": 0 of 1 instances rolled out`,
+			},
+		},
+		"old template": {
+			Instances: []interface{}{
+				instance("a", stable, "NONE", "HEALTHY"),
+				instance("b", prefix+"global/instanceTemplates/old", "REFRESHING", "HEALTHY"),
+			},
+			Expected: []string{
+				"b: status RUNNING, current action REFRESHING, health HEALTHY, template old",
+				`version "canary": 0 of 1 instances rolled out`,
+			},
+		},
+		"creating": {
+			Instances: []interface{}{
+				instance("a", canary, "NONE", "HEALTHY"),
+			},
+			Expected: []string{
+				"1 of 2 instances created",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := pendingManagedInstances(manager, tc.Instances); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
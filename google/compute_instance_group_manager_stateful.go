@@ -0,0 +1,146 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+	"google.golang.org/api/compute/v1"
+)
+
+// The stateful policy and per-instance configs of instance group managers
+// aren't in the compute client yet, they're sent as raw JSON to the beta API.
+
+// expandStatefulPolicy returns the statefulPolicy of an instance group
+// manager for its stateful_disk blocks. The API merges the disks map with the
+// current policy, so disks that were removed from the config are sent as null
+// to remove them from the policy.
+func expandStatefulPolicy(d *schema.ResourceData) map[string]interface{} {
+	disks := make(map[string]interface{})
+
+	o, n := d.GetChange("stateful_disk")
+	for _, raw := range o.(*schema.Set).List() {
+		disks[raw.(map[string]interface{})["device_name"].(string)] = nil
+	}
+	for _, raw := range n.(*schema.Set).List() {
+		disk := raw.(map[string]interface{})
+		disks[disk["device_name"].(string)] = map[string]interface{}{
+			"autoDelete": disk["delete_rule"],
+		}
+	}
+
+	return map[string]interface{}{
+		"preservedState": map[string]interface{}{
+			"disks": disks,
+		},
+	}
+}
+
+func flattenStatefulPolicy(v interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	policy, ok := v.(map[string]interface{})
+	if !ok {
+		return result
+	}
+	preservedState, ok := policy["preservedState"].(map[string]interface{})
+	if !ok {
+		return result
+	}
+	disks, ok := preservedState["disks"].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	for _, deviceName := range sortedMapKeys(disks) {
+		disk := disks[deviceName].(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"device_name": deviceName,
+			"delete_rule": disk["autoDelete"],
+		})
+	}
+	return result
+}
+
+// insertInstanceGroupManagerWithStatefulPolicy inserts the instance group
+// manager at url with its stateful policy, so that the disks of its first
+// instances are already preserved.
+func insertInstanceGroupManagerWithStatefulPolicy(d *schema.ResourceData, config *Config, url string, manager *computeBeta.InstanceGroupManager) (*computeBeta.Operation, error) {
+	obj, err := toRawJSON(manager)
+	if err != nil {
+		return nil, err
+	}
+	obj["statefulPolicy"] = expandStatefulPolicy(d)
+
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &computeBeta.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// patchStatefulPolicy updates the stateful policy of the instance group
+// manager at url.
+func patchStatefulPolicy(d *schema.ResourceData, config *Config, project, url string) error {
+	obj := map[string]interface{}{
+		"statefulPolicy": expandStatefulPolicy(d),
+	}
+
+	log.Printf("[DEBUG] Updating stateful policy of instance group manager %q: %#v", d.Id(), obj)
+	res, err := sendRequest(config, "PATCH", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error updating stateful policy of instance group manager %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeSharedOperationWait(config.clientCompute, op, project, "Updating stateful policy")
+}
+
+// readStatefulDisks reads the stateful_disk blocks of the instance group
+// manager at url.
+func readStatefulDisks(config *Config, url string) ([]map[string]interface{}, error) {
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading stateful policy of instance group manager: %s", err)
+	}
+
+	return flattenStatefulPolicy(res["statefulPolicy"]), nil
+}
+
+func sortedMapKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateStatefulUpdatePolicy checks that rolling updates of an instance
+// group manager with stateful disks keep the preserved state. Instances with
+// preserved state keep their name, they can't be surged alongside the ones
+// they replace.
+func validateStatefulUpdatePolicy(d *schema.ResourceData) error {
+	if d.Get("stateful_disk").(*schema.Set).Len() == 0 || d.Get("update_strategy").(string) != "ROLLING_UPDATE" {
+		return nil
+	}
+
+	for _, raw := range d.Get("rolling_update_policy").([]interface{}) {
+		policy := raw.(map[string]interface{})
+		if policy["max_surge_fixed"].(int) != 0 || policy["max_surge_percent"].(int) != 0 {
+			return fmt.Errorf("[rolling_update_policy] 'max_surge_fixed' must be set to 0 and 'max_surge_percent' must be unset when 'stateful_disk' is set, instances with preserved state are replaced in place")
+		}
+	}
+
+	return nil
+}
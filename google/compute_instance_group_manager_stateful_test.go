@@ -0,0 +1,59 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenStatefulPolicy(t *testing.T) {
+	cases := map[string]struct {
+		Policy   interface{}
+		Expected []map[string]interface{}
+	}{
+		"no policy": {
+			Policy:   nil,
+			Expected: []map[string]interface{}{},
+		},
+		"no disks": {
+			Policy: map[string]interface{}{
+				"preservedState": map[string]interface{}{},
+			},
+			Expected: []map[string]interface{}{},
+		},
+		"disks sorted by device name": {
+			Policy: map[string]interface{}{
+				"preservedState": map[string]interface{}{
+					"disks": map[string]interface{}{
+						"logs": map[string]interface{}{"autoDelete": "ON_PERMANENT_INSTANCE_DELETION"},
+						"data": map[string]interface{}{"autoDelete": "NEVER"},
+					},
+				},
+			},
+			Expected: []map[string]interface{}{
+				{"device_name": "data", "delete_rule": "NEVER"},
+				{"device_name": "logs", "delete_rule": "ON_PERMANENT_INSTANCE_DELETION"},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := flattenStatefulPolicy(tc.Policy); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestExpandPerInstanceConfigIpAddress(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"10.128.0.10": {"literal": "10.128.0.10"},
+		"https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/addresses/my-address": {
+			"address": "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/addresses/my-address",
+		},
+	}
+
+	for v, expected := range cases {
+		if got := expandPerInstanceConfigIpAddress(v); !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected %v, got %v", v, expected, got)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	computeBeta "google.golang.org/api/compute/v0.beta"
 )
 
@@ -30,11 +31,67 @@ func flattenAliasIpRange(ranges []*computeBeta.AliasIpRange) []map[string]interf
 	return rangesSchema
 }
 
+func instanceSchedulingNodeAffinitiesElemSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"operator": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"IN", "NOT_IN"}, false),
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func expandNodeAffinities(v interface{}) []*computeBeta.SchedulingNodeAffinity {
+	affinities, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	nodeAffinities := make([]*computeBeta.SchedulingNodeAffinity, 0, affinities.Len())
+	for _, raw := range affinities.List() {
+		data := raw.(map[string]interface{})
+		nodeAffinities = append(nodeAffinities, &computeBeta.SchedulingNodeAffinity{
+			Key:      data["key"].(string),
+			Operator: data["operator"].(string),
+			Values:   convertStringArr(data["values"].(*schema.Set).List()),
+		})
+	}
+	return nodeAffinities
+}
+
+func flattenNodeAffinities(nodeAffinities []*computeBeta.SchedulingNodeAffinity) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(nodeAffinities))
+	for _, affinity := range nodeAffinities {
+		result = append(result, map[string]interface{}{
+			"key":      affinity.Key,
+			"operator": affinity.Operator,
+			"values":   schema.NewSet(schema.HashString, convertStringArrToInterface(affinity.Values)),
+		})
+	}
+	return result
+}
+
 func flattenScheduling(scheduling *computeBeta.Scheduling) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 	schedulingMap := map[string]interface{}{
 		"on_host_maintenance": scheduling.OnHostMaintenance,
 		"preemptible":         scheduling.Preemptible,
+		"node_affinities":     flattenNodeAffinities(scheduling.NodeAffinities),
 	}
 	if scheduling.AutomaticRestart != nil {
 		schedulingMap["automatic_restart"] = *scheduling.AutomaticRestart
@@ -0,0 +1,60 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// waitForManagedSslCertificateActive waits until the managed certificate of
+// d is provisioned. Google only provisions it once the DNS records of all of
+// its domains point at a load balancer that uses the certificate.
+func waitForManagedSslCertificateActive(d *schema.ResourceData, config *Config, timeout time.Duration) error {
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/sslCertificates/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	conf := &resource.StateChangeConf{
+		// Failed provisioning and renewals are retried by Google.
+		Pending:      []string{"MANAGED_CERTIFICATE_STATUS_UNSPECIFIED", "PROVISIONING", "PROVISIONING_FAILED", "RENEWAL_FAILED"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      managedSslCertificateStatusRefreshFunc(config, url),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+	if _, err := conf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for ManagedSslCertificate %q to become active: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func managedSslCertificateStatusRefreshFunc(config *Config, url string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			return nil, "", err
+		}
+
+		managed, _ := res["managed"].(map[string]interface{})
+		status, _ := managed["status"].(string)
+		if status == "PROVISIONING_FAILED_PERMANENTLY" {
+			return nil, "", fmt.Errorf("provisioning failed permanently: %s", formatManagedSslCertificateDomainStatus(managed["domainStatus"]))
+		}
+		return res, status, nil
+	}
+}
+
+// formatManagedSslCertificateDomainStatus formats the per-domain status of
+// a managed certificate as "domain: status" pairs, sorted by domain.
+func formatManagedSslCertificateDomainStatus(v interface{}) string {
+	domainStatus, _ := v.(map[string]interface{})
+	domains := make([]string, 0, len(domainStatus))
+	for _, domain := range sortedMapKeys(domainStatus) {
+		domains = append(domains, fmt.Sprintf("%s: %v", domain, domainStatus[domain]))
+	}
+	return strings.Join(domains, ", ")
+}
@@ -0,0 +1,30 @@
+package google
+
+import (
+	"testing"
+)
+
+func TestFormatManagedSslCertificateDomainStatus(t *testing.T) {
+	cases := map[string]struct {
+		DomainStatus interface{}
+		Expected     string
+	}{
+		"no status": {
+			DomainStatus: nil,
+			Expected:     "",
+		},
+		"sorted by domain": {
+			DomainStatus: map[string]interface{}{
+				"www.example.com": "FAILED_NOT_VISIBLE",
+				"example.com":     "ACTIVE",
+			},
+			Expected: "example.com: ACTIVE, www.example.com: FAILED_NOT_VISIBLE",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := formatManagedSslCertificateDomainStatus(tc.DomainStatus); got != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
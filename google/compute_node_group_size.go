@@ -0,0 +1,93 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	compute "google.golang.org/api/compute/v1"
+)
+
+// resizeNodeGroup changes the number of nodes of the node group of d to its
+// size in place. Only nodes that run no instances are deleted, so shrinking
+// the group never evicts instances from their hosts.
+func resizeNodeGroup(d *schema.ResourceData, config *Config, project string, timeout time.Duration) error {
+	o, n := d.GetChange("size")
+	oldSize, newSize := o.(int), n.(int)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/nodeGroups/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var res map[string]interface{}
+	if newSize > oldSize {
+		log.Printf("[DEBUG] Adding %d nodes to NodeGroup %q", newSize-oldSize, d.Id())
+		res, err = sendRequest(config, "POST", url+"/addNodes", map[string]interface{}{
+			"additionalNodeCount": newSize - oldSize,
+		})
+	} else {
+		var nodes []string
+		nodes, err = emptyNodeGroupNodes(config, url)
+		if err != nil {
+			return err
+		}
+		if len(nodes) < oldSize-newSize {
+			return fmt.Errorf("Error resizing NodeGroup %q to %d nodes: only %d of its nodes run no instances, move or delete the instances of the nodes to remove first", d.Id(), newSize, len(nodes))
+		}
+		nodes = nodes[:oldSize-newSize]
+
+		log.Printf("[DEBUG] Deleting nodes %v of NodeGroup %q", nodes, d.Id())
+		res, err = sendRequest(config, "POST", url+"/deleteNodes", map[string]interface{}{
+			"nodes": nodes,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("Error resizing NodeGroup %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(config.clientCompute, op, project, "Resizing NodeGroup", int(timeout.Minutes()))
+}
+
+// emptyNodeGroupNodes returns the names of the nodes of the node group at url
+// that run no instances.
+func emptyNodeGroupNodes(config *Config, url string) ([]string, error) {
+	url += "/listNodes"
+
+	nodes := make([]string, 0)
+	pageToken := ""
+	for {
+		pageUrl := url
+		if pageToken != "" {
+			var err error
+			pageUrl, err = addQueryParams(url, map[string]string{"pageToken": pageToken})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := sendRequest(config, "POST", pageUrl, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing nodes of NodeGroup: %s", err)
+		}
+
+		items, _ := res["items"].([]interface{})
+		for _, raw := range items {
+			node := raw.(map[string]interface{})
+			if instances, _ := node["instances"].([]interface{}); len(instances) == 0 {
+				nodes = append(nodes, node["name"].(string))
+			}
+		}
+
+		pageToken, _ = res["nextPageToken"].(string)
+		if pageToken == "" {
+			return nodes, nil
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+// expandComputeResourcePolicies returns the relative links of the resource
+// policies in v. Policies given by name are looked up in the region of the
+// resource, or the region of its zone.
+func expandComputeResourcePolicies(v interface{}, d TerraformResourceData, config *Config) ([]interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		f, err := parseRegionalFieldValue("resourcePolicies", raw.(string), "project", "region", "zone", d, config, true)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for resource_policies: %s", err)
+		}
+		req = append(req, f.RelativeLink())
+	}
+	return req, nil
+}
+
+// diffResourcePolicies returns the policies of old missing from new, which
+// need to be removed, and the policies of new missing from old, which need to
+// be added.
+func diffResourcePolicies(old, new []interface{}) (remove, add []interface{}) {
+	oldSet := make(map[string]struct{}, len(old))
+	for _, p := range old {
+		oldSet[p.(string)] = struct{}{}
+	}
+	newSet := make(map[string]struct{}, len(new))
+	for _, p := range new {
+		newSet[p.(string)] = struct{}{}
+	}
+
+	remove = make([]interface{}, 0)
+	for _, p := range old {
+		if _, ok := newSet[p.(string)]; !ok {
+			remove = append(remove, p)
+		}
+	}
+	add = make([]interface{}, 0)
+	for _, p := range new {
+		if _, ok := oldSet[p.(string)]; !ok {
+			add = append(add, p)
+		}
+	}
+	return remove, add
+}
+
+// updateComputeResourcePolicies detaches the resource policies removed from
+// resource_policies and attaches the added ones, through the
+// removeResourcePolicies and addResourcePolicies methods of the resource at
+// url.
+func updateComputeResourcePolicies(d *schema.ResourceData, config *Config, project, url string) error {
+	o, n := d.GetChange("resource_policies")
+	oldPolicies, err := expandComputeResourcePolicies(o, d, config)
+	if err != nil {
+		return err
+	}
+	newPolicies, err := expandComputeResourcePolicies(n, d, config)
+	if err != nil {
+		return err
+	}
+
+	remove, add := diffResourcePolicies(oldPolicies, newPolicies)
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing resource policies %v from %q", remove, d.Id())
+		if err := sendResourcePoliciesRequest(config, project, url+"/removeResourcePolicies", remove, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error removing resource policies from %q: %s", d.Id(), err)
+		}
+	}
+	if len(add) > 0 {
+		log.Printf("[DEBUG] Adding resource policies %v to %q", add, d.Id())
+		if err := sendResourcePoliciesRequest(config, project, url+"/addResourcePolicies", add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error adding resource policies to %q: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func sendResourcePoliciesRequest(config *Config, project, url string, policies []interface{}, timeout time.Duration) error {
+	res, err := sendRequest(config, "POST", url, map[string]interface{}{
+		"resourcePolicies": policies,
+	})
+	if err != nil {
+		return err
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(
+		config.clientCompute, op, project, "Updating resource policies",
+		int(timeout.Minutes()))
+}
@@ -0,0 +1,59 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffResourcePolicies(t *testing.T) {
+	t.Parallel()
+
+	daily := "projects/test-project/regions/us-central1/resourcePolicies/daily"
+	weekly := "projects/test-project/regions/us-central1/resourcePolicies/weekly"
+
+	cases := map[string]struct {
+		Old, New    []interface{}
+		Remove, Add []interface{}
+	}{
+		"unchanged": {
+			Old:    []interface{}{daily},
+			New:    []interface{}{daily},
+			Remove: []interface{}{},
+			Add:    []interface{}{},
+		},
+		"attach": {
+			Old:    []interface{}{},
+			New:    []interface{}{daily},
+			Remove: []interface{}{},
+			Add:    []interface{}{daily},
+		},
+		"detach": {
+			Old:    []interface{}{daily, weekly},
+			New:    []interface{}{weekly},
+			Remove: []interface{}{daily},
+			Add:    []interface{}{},
+		},
+		"replace": {
+			Old:    []interface{}{daily},
+			New:    []interface{}{weekly},
+			Remove: []interface{}{daily},
+			Add:    []interface{}{weekly},
+		},
+		"reorder": {
+			Old:    []interface{}{daily, weekly},
+			New:    []interface{}{weekly, daily},
+			Remove: []interface{}{},
+			Add:    []interface{}{},
+		},
+	}
+
+	for tn, tc := range cases {
+		remove, add := diffResourcePolicies(tc.Old, tc.New)
+		if !reflect.DeepEqual(remove, tc.Remove) {
+			t.Errorf("bad: %s, expected to remove %v, got %v", tn, tc.Remove, remove)
+		}
+		if !reflect.DeepEqual(add, tc.Add) {
+			t.Errorf("bad: %s, expected to add %v, got %v", tn, tc.Add, add)
+		}
+	}
+}
@@ -49,6 +49,7 @@ import (
 // provider.
 type Config struct {
 	Credentials string
+	AccessToken string
 	Project     string
 	Region      string
 	Zone        string
@@ -102,7 +103,11 @@ func (c *Config) loadAndValidate() error {
 	var client *http.Client
 	var tokenSource oauth2.TokenSource
 
-	if c.Credentials != "" {
+	if c.AccessToken != "" {
+		log.Printf("[INFO] Authenticating using configured 'access_token'...")
+		tokenSource = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: c.AccessToken})
+		client = oauth2.NewClient(context.Background(), tokenSource)
+	} else if c.Credentials != "" {
 		contents, _, err := pathorcontents.Read(c.Credentials)
 		if err != nil {
 			return fmt.Errorf("Error loading credentials: %s", err)
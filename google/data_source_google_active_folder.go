@@ -3,8 +3,10 @@ package google
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/net/context"
 	resourceManagerV2Beta1 "google.golang.org/api/cloudresourcemanager/v2beta1"
 )
 
@@ -14,12 +16,21 @@ func dataSourceGoogleActiveFolder() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"parent": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"path"},
 			},
 			"display_name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"path"},
+			},
+			"path": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parent", "display_name"},
 			},
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -32,19 +43,31 @@ func dataSourceGoogleActiveFolder() *schema.Resource {
 func dataSourceGoogleActiveFolderRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if v, ok := d.GetOk("path"); ok {
+		folder, err := resolveActiveFolderPath(v.(string), config)
+		if err != nil {
+			return err
+		}
+
+		d.SetId(folder.Name)
+		d.Set("name", folder.Name)
+		d.Set("parent", folder.Parent)
+		d.Set("display_name", folder.DisplayName)
+		return nil
+	}
+
 	parent := d.Get("parent").(string)
 	displayName := d.Get("display_name").(string)
-
-	queryString := fmt.Sprintf("lifecycleState=ACTIVE AND parent=%s AND displayName=%s", parent, url.QueryEscape(displayName))
-	searchRequest := &resourceManagerV2Beta1.SearchFoldersRequest{
-		Query: queryString,
+	if parent == "" || displayName == "" {
+		return fmt.Errorf("One of path or both parent and display_name must be set")
 	}
-	searchResponse, err := config.clientResourceManagerV2Beta1.Folders.Search(searchRequest).Do()
+
+	folders, err := searchActiveFolders(parent, displayName, config)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Folder Not Found : %s", displayName))
 	}
 
-	for _, folder := range searchResponse.Folders {
+	for _, folder := range folders {
 		if folder.DisplayName == displayName {
 			d.SetId(folder.Name)
 			d.Set("name", folder.Name)
@@ -53,3 +76,76 @@ func dataSourceGoogleActiveFolderRead(d *schema.ResourceData, meta interface{})
 	}
 	return fmt.Errorf("Folder not found")
 }
+
+// resolveActiveFolderPath returns the active folder at a path made of an
+// organization or folder resource name followed by folder display names, e.g.
+// "organizations/123/Engineering/Platform/Prod". Each level must match
+// exactly one active folder.
+func resolveActiveFolderPath(path string, config *Config) (*resourceManagerV2Beta1.Folder, error) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 3 || (parts[0] != "organizations" && parts[0] != "folders") {
+		return nil, fmt.Errorf("Invalid folder path %q, expected organizations/{org_id}/{display_name}[/{display_name}...] or folders/{folder_id}/{display_name}[/{display_name}...]", path)
+	}
+
+	parent := parts[0] + "/" + parts[1]
+	var folder *resourceManagerV2Beta1.Folder
+	for i, displayName := range parts[2:] {
+		resolved := strings.Join(parts[:i+2], "/")
+		if displayName == "" {
+			return nil, fmt.Errorf("Invalid folder path %q: empty folder name after %q", path, resolved)
+		}
+
+		location := resolved
+		if location != parent {
+			location = fmt.Sprintf("%s (%s)", resolved, parent)
+		}
+
+		folders, err := searchActiveFolders(parent, displayName, config)
+		if err != nil {
+			return nil, fmt.Errorf("Error searching for folder %q under %s: %s", displayName, location, err)
+		}
+
+		matches := make([]*resourceManagerV2Beta1.Folder, 0, 1)
+		for _, f := range folders {
+			if f.DisplayName == displayName {
+				matches = append(matches, f)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("Folder %q not found under %s", displayName, location)
+		case 1:
+			folder = matches[0]
+			parent = folder.Name
+		default:
+			names := make([]string, 0, len(matches))
+			for _, f := range matches {
+				names = append(names, f.Name)
+			}
+			return nil, fmt.Errorf("Folder %q is ambiguous under %s, found %s", displayName, location, strings.Join(names, ", "))
+		}
+	}
+
+	return folder, nil
+}
+
+// searchActiveFolders returns the active folders directly under parent whose
+// display name matches the search query for displayName.
+func searchActiveFolders(parent, displayName string, config *Config) ([]*resourceManagerV2Beta1.Folder, error) {
+	queryString := fmt.Sprintf("lifecycleState=ACTIVE AND parent=%s AND displayName=%s", parent, url.QueryEscape(displayName))
+	searchRequest := &resourceManagerV2Beta1.SearchFoldersRequest{
+		Query: queryString,
+	}
+
+	folders := make([]*resourceManagerV2Beta1.Folder, 0)
+	err := config.clientResourceManagerV2Beta1.Folders.Search(searchRequest).Pages(context.Background(), func(resp *resourceManagerV2Beta1.SearchFoldersResponse) error {
+		folders = append(folders, resp.Folders...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return folders, nil
+}
@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -49,6 +50,30 @@ func TestAccDataSourceGoogleActiveFolder_space(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceGoogleActiveFolder_path(t *testing.T) {
+	org := getTestOrgFromEnv(t)
+
+	parent := fmt.Sprintf("organizations/%s", org)
+	displayName := "terraform-test-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDataSourceGoogleActiveFolderPathConfig(parent, displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceGoogleActiveFolderCheck("data.google_active_folder.my_folder", "google_folder.child"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccDataSourceGoogleActiveFolderMissingPathConfig(parent, displayName),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Folder \"missing\" not found under %s/%s", parent, displayName)),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGoogleActiveFolderCheck(data_source_name string, resource_name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ds, ok := s.RootModule().Resources[data_source_name]
@@ -92,3 +117,39 @@ data "google_active_folder" "my_folder" {
 }
 `, parent, displayName)
 }
+
+func testAccDataSourceGoogleActiveFolderPathConfig(parent string, displayName string) string {
+	return fmt.Sprintf(`
+resource "google_folder" "foobar" {
+  parent = "%s"
+  display_name = "%s"
+}
+
+resource "google_folder" "child" {
+  parent = "${google_folder.foobar.name}"
+  display_name = "%s child"
+}
+
+data "google_active_folder" "my_folder" {
+  path = "%s/${google_folder.foobar.display_name}/${google_folder.child.display_name}"
+}
+`, parent, displayName, displayName, parent)
+}
+
+func testAccDataSourceGoogleActiveFolderMissingPathConfig(parent string, displayName string) string {
+	return fmt.Sprintf(`
+resource "google_folder" "foobar" {
+  parent = "%s"
+  display_name = "%s"
+}
+
+resource "google_folder" "child" {
+  parent = "${google_folder.foobar.name}"
+  display_name = "%s child"
+}
+
+data "google_active_folder" "my_folder" {
+  path = "%s/${google_folder.foobar.display_name}/missing"
+}
+`, parent, displayName, displayName, parent)
+}
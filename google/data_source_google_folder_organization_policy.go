@@ -0,0 +1,37 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func dataSourceGoogleFolderOrganizationPolicy() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceGoogleFolderOrganizationPolicy().Schema)
+
+	addRequiredFieldsToSchema(dsSchema, "folder", "constraint")
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleFolderOrganizationPolicyRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleFolderOrganizationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	folder := canonicalFolderId(d.Get("folder").(string))
+
+	policy, err := config.clientResourceManager.Folders.GetEffectiveOrgPolicy(folder, &cloudresourcemanager.GetEffectiveOrgPolicyRequest{
+		Constraint: canonicalOrgPolicyConstraint(d.Get("constraint").(string)),
+	}).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading effective organization policy for %s: %s", folder, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", folder, policy.Constraint))
+	setEffectiveOrganizationPolicy(d, policy)
+
+	return nil
+}
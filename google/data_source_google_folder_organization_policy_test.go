@@ -0,0 +1,55 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleFolderOrganizationPolicy_effective(t *testing.T) {
+	t.Parallel()
+
+	folder := acctest.RandomWithPrefix("tf-test")
+	org := getTestOrgFromEnv(t)
+	resourceName := "data.google_folder_organization_policy.effective"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleFolderOrganizationPolicy_effective(org, folder),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "constraint", "constraints/compute.disableSerialPortAccess"),
+					resource.TestCheckResourceAttr(resourceName, "boolean_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "boolean_policy.0.enforced", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleFolderOrganizationPolicy_effective(org, folder string) string {
+	return fmt.Sprintf(`
+resource "google_folder" "orgpolicy" {
+  display_name = "%s"
+  parent       = "organizations/%s"
+}
+
+resource "google_folder_organization_policy" "bool" {
+  folder     = "${google_folder.orgpolicy.name}"
+  constraint = "constraints/compute.disableSerialPortAccess"
+
+  boolean_policy {
+    enforced = true
+  }
+}
+
+data "google_folder_organization_policy" "effective" {
+  folder     = "${google_folder_organization_policy.bool.folder}"
+  constraint = "${google_folder_organization_policy.bool.constraint}"
+}
+`, folder, org)
+}
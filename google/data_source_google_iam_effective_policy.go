@@ -0,0 +1,249 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func dataSourceGoogleIamEffectivePolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleIamEffectivePolicyRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"folder", "org_id"},
+			},
+			"folder": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"project", "org_id"},
+			},
+			"org_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"project", "folder"},
+			},
+			"ancestry": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"bindings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"grants": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"member": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleIamEffectivePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	var resource string
+	if v, ok := d.GetOk("folder"); ok {
+		resource = canonicalFolderName(v.(string))
+	} else if v, ok := d.GetOk("org_id"); ok {
+		resource = "organizations/" + strings.TrimPrefix(v.(string), "organizations/")
+	} else {
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+		resource = "projects/" + project
+	}
+
+	ancestry, err := getResourceAncestry(resource, config)
+	if err != nil {
+		return err
+	}
+
+	policies := make(map[string]*cloudresourcemanager.Policy, len(ancestry))
+	for _, ancestor := range ancestry {
+		updater, err := newAncestorIamUpdater(ancestor, config)
+		if err != nil {
+			return err
+		}
+
+		p, err := updater.GetResourceIamPolicy()
+		if err != nil {
+			return err
+		}
+		policies[ancestor] = p
+	}
+
+	grants := flattenIamEffectiveGrants(ancestry, policies)
+
+	d.SetId(resource)
+	d.Set("ancestry", ancestry)
+	d.Set("bindings", flattenIamEffectiveBindings(grants))
+	d.Set("grants", grants)
+
+	return nil
+}
+
+// getResourceAncestry returns the resource names of resource and its
+// ancestors, ordered from the bottom to the top of the resource hierarchy,
+// e.g. ["projects/my-project", "folders/123", "organizations/456"].
+func getResourceAncestry(resource string, config *Config) ([]string, error) {
+	switch {
+	case strings.HasPrefix(resource, "projects/"):
+		project := strings.TrimPrefix(resource, "projects/")
+		resp, err := config.clientResourceManager.Projects.GetAncestry(project, &cloudresourcemanager.GetAncestryRequest{}).Do()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving ancestry for project %q: %s", project, err)
+		}
+
+		ancestry := make([]string, 0, len(resp.Ancestor))
+		for _, a := range resp.Ancestor {
+			if a.ResourceId == nil {
+				continue
+			}
+			ancestry = append(ancestry, fmt.Sprintf("%ss/%s", a.ResourceId.Type, a.ResourceId.Id))
+		}
+		return ancestry, nil
+	case strings.HasPrefix(resource, "folders/"):
+		ancestry := []string{}
+		for parent := resource; strings.HasPrefix(parent, "folders/"); {
+			folder, err := config.clientResourceManagerV2Beta1.Folders.Get(parent).Do()
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving folder %q: %s", parent, err)
+			}
+			ancestry = append(ancestry, folder.Name)
+			parent = folder.Parent
+			if strings.HasPrefix(parent, "organizations/") {
+				ancestry = append(ancestry, parent)
+			}
+		}
+		return ancestry, nil
+	case strings.HasPrefix(resource, "organizations/"):
+		return []string{resource}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported resource %q, expected a project, folder or organization", resource)
+}
+
+// newAncestorIamUpdater returns the ResourceIamUpdater for a project, folder
+// or organization resource name as returned by getResourceAncestry.
+func newAncestorIamUpdater(resource string, config *Config) (ResourceIamUpdater, error) {
+	switch {
+	case strings.HasPrefix(resource, "projects/"):
+		return &ProjectIamUpdater{
+			resourceId: strings.TrimPrefix(resource, "projects/"),
+			Config:     config,
+		}, nil
+	case strings.HasPrefix(resource, "folders/"):
+		return &FolderIamUpdater{
+			folderId: resource,
+			Config:   config,
+		}, nil
+	case strings.HasPrefix(resource, "organizations/"):
+		return &OrganizationIamUpdater{
+			resourceId: strings.TrimPrefix(resource, "organizations/"),
+			Config:     config,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported resource %q, expected a project, folder or organization", resource)
+}
+
+// flattenIamEffectiveGrants returns one grant per role, member and level of
+// the hierarchy granting it, sorted by role, member and then from the bottom
+// to the top of the hierarchy.
+func flattenIamEffectiveGrants(ancestry []string, policies map[string]*cloudresourcemanager.Policy) []map[string]interface{} {
+	level := make(map[string]int, len(ancestry))
+	for i, a := range ancestry {
+		level[a] = i
+	}
+
+	grants := make([]map[string]interface{}, 0)
+	for _, resource := range ancestry {
+		p, ok := policies[resource]
+		if !ok {
+			continue
+		}
+
+		for role, members := range rolesToMembersMap(normalizeIamBindings(p.Bindings, false)) {
+			for member := range members {
+				grants = append(grants, map[string]interface{}{
+					"role":     role,
+					"member":   member,
+					"resource": resource,
+				})
+			}
+		}
+	}
+
+	sort.Slice(grants, func(i, j int) bool {
+		a, b := grants[i], grants[j]
+		if a["role"] != b["role"] {
+			return a["role"].(string) < b["role"].(string)
+		}
+		if a["member"] != b["member"] {
+			return a["member"].(string) < b["member"].(string)
+		}
+		return level[a["resource"].(string)] < level[b["resource"].(string)]
+	})
+
+	return grants
+}
+
+// flattenIamEffectiveBindings returns the effective members per role from a
+// sorted list of grants.
+func flattenIamEffectiveBindings(grants []map[string]interface{}) []map[string]interface{} {
+	bindings := make([]map[string]interface{}, 0)
+	for _, g := range grants {
+		role, member := g["role"].(string), g["member"].(string)
+
+		if len(bindings) == 0 || bindings[len(bindings)-1]["role"] != role {
+			bindings = append(bindings, map[string]interface{}{
+				"role":    role,
+				"members": []string{},
+			})
+		}
+
+		last := bindings[len(bindings)-1]
+		members := last["members"].([]string)
+		if len(members) == 0 || members[len(members)-1] != member {
+			last["members"] = append(members, member)
+		}
+	}
+	return bindings
+}
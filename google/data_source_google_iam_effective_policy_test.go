@@ -0,0 +1,84 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestFlattenIamEffectivePolicy(t *testing.T) {
+	ancestry := []string{"projects/my-project", "folders/123", "organizations/456"}
+	policies := map[string]*cloudresourcemanager.Policy{
+		"projects/my-project": {
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/viewer", Members: []string{"user:jane@example.com", "User:John@example.com"}},
+				{Role: "roles/editor", Members: []string{"group:admins@example.com"}},
+			},
+		},
+		"folders/123": {
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/viewer", Members: []string{"user:jane@example.com"}},
+			},
+		},
+		"organizations/456": {
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{"user:jane@example.com"}},
+			},
+		},
+	}
+
+	grants := flattenIamEffectiveGrants(ancestry, policies)
+	expectedGrants := []map[string]interface{}{
+		{"role": "roles/editor", "member": "group:admins@example.com", "resource": "projects/my-project"},
+		{"role": "roles/editor", "member": "user:jane@example.com", "resource": "organizations/456"},
+		{"role": "roles/viewer", "member": "user:jane@example.com", "resource": "projects/my-project"},
+		{"role": "roles/viewer", "member": "user:jane@example.com", "resource": "folders/123"},
+		{"role": "roles/viewer", "member": "user:john@example.com", "resource": "projects/my-project"},
+	}
+	if !reflect.DeepEqual(grants, expectedGrants) {
+		t.Errorf("got grants %+v, expected %+v", grants, expectedGrants)
+	}
+
+	bindings := flattenIamEffectiveBindings(grants)
+	expectedBindings := []map[string]interface{}{
+		{"role": "roles/editor", "members": []string{"group:admins@example.com", "user:jane@example.com"}},
+		{"role": "roles/viewer", "members": []string{"user:jane@example.com", "user:john@example.com"}},
+	}
+	if !reflect.DeepEqual(bindings, expectedBindings) {
+		t.Errorf("got bindings %+v, expected %+v", bindings, expectedBindings)
+	}
+}
+
+func TestAccDataSourceGoogleIamEffectivePolicy_project(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+	resourceName := "data.google_iam_effective_policy.project"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleIamEffectivePolicy_project(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "projects/"+project),
+					resource.TestCheckResourceAttr(resourceName, "ancestry.0", "projects/"+project),
+					resource.TestCheckResourceAttrSet(resourceName, "bindings.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "grants.0.resource"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleIamEffectivePolicy_project(project string) string {
+	return fmt.Sprintf(`
+data "google_iam_effective_policy" "project" {
+  project = "%s"
+}
+`, project)
+}
@@ -71,7 +71,7 @@ func dataSourceGoogleIamPolicyRead(d *schema.ResourceData, meta interface{}) err
 		binding := v.(map[string]interface{})
 		policy.Bindings[i] = &cloudresourcemanager.Binding{
 			Role:    binding["role"].(string),
-			Members: convertStringSet(binding["members"].(*schema.Set)),
+			Members: normalizeIamMembers(convertStringSet(binding["members"].(*schema.Set)), false),
 		}
 	}
 
@@ -0,0 +1,64 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleIamRole() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleIamRoleRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stage": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"included_permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleIamRoleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// Predefined roles (roles/*) as well as project and organization custom
+	// roles (projects/*/roles/*, organizations/*/roles/*) are all resolved
+	// relative to the API root.
+	roleName := d.Get("name").(string)
+	role, err := config.clientIAM.Roles.Get(roleName).Do()
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Role %q not found", roleName)
+		}
+		return fmt.Errorf("Error reading role %q: %s", roleName, err)
+	}
+
+	if role.Deleted {
+		return fmt.Errorf("Role %q has been deleted", roleName)
+	}
+
+	d.SetId(role.Name)
+	d.Set("title", role.Title)
+	d.Set("description", role.Description)
+	d.Set("stage", role.Stage)
+	d.Set("included_permissions", role.IncludedPermissions)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleIamRole_basic(t *testing.T) {
+	t.Parallel()
+
+	name := "roles/viewer"
+	resourceName := "data.google_iam_role.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleIamRole_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "title", "Viewer"),
+					resource.TestCheckResourceAttr(resourceName, "stage", "GA"),
+					resource.TestCheckResourceAttrSet(resourceName, "included_permissions.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceGoogleIamRole_notFound(t *testing.T) {
+	t.Parallel()
+
+	name := "roles/notARealRole"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceGoogleIamRole_basic(name),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Role %q not found", name)),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleIamRole_basic(name string) string {
+	return fmt.Sprintf(`
+data "google_iam_role" "role" {
+  name = "%s"
+}
+`, name)
+}
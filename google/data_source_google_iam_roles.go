@@ -0,0 +1,127 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/net/context"
+	"google.golang.org/api/iam/v1"
+)
+
+func dataSourceGoogleIamRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleIamRolesRead,
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"permission": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleIamRolesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	parent := d.Get("parent").(string)
+	prefix := d.Get("prefix").(string)
+	permission := d.Get("permission").(string)
+
+	call := config.clientIAM.Roles.List().Parent(parent).PageSize(1000)
+	if permission != "" {
+		// The permissions of a role are only returned in the FULL view.
+		call = call.View("FULL")
+	}
+
+	var roles []*iam.Role
+	err := call.Pages(context.Background(), func(r *iam.ListRolesResponse) error {
+		for _, role := range r.Roles {
+			if filterIamRole(role, prefix, permission) {
+				roles = append(roles, role)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errwrap.Wrapf("Error listing roles: {{err}}", err)
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+
+	names := make([]string, 0, len(roles))
+	flattened := make([]map[string]interface{}, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+		flattened = append(flattened, map[string]interface{}{
+			"name":        role.Name,
+			"title":       role.Title,
+			"description": role.Description,
+			"stage":       role.Stage,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", parent, prefix, permission))
+	d.Set("names", names)
+	d.Set("roles", flattened)
+
+	return nil
+}
+
+// filterIamRole returns true if the role's name starts with prefix and, when a
+// permission is given, the role includes that permission.
+func filterIamRole(role *iam.Role, prefix, permission string) bool {
+	if role.Deleted || !strings.HasPrefix(role.Name, prefix) {
+		return false
+	}
+
+	if permission == "" {
+		return true
+	}
+
+	for _, p := range role.IncludedPermissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/iam/v1"
+)
+
+func TestFilterIamRole(t *testing.T) {
+	role := &iam.Role{
+		Name:                "roles/compute.viewer",
+		IncludedPermissions: []string{"compute.instances.get", "compute.instances.list"},
+	}
+
+	cases := map[string]struct {
+		Role       *iam.Role
+		Prefix     string
+		Permission string
+		Expected   bool
+	}{
+		"no filter": {
+			Role:     role,
+			Expected: true,
+		},
+		"matching prefix": {
+			Role:     role,
+			Prefix:   "roles/compute.",
+			Expected: true,
+		},
+		"other prefix": {
+			Role:     role,
+			Prefix:   "roles/storage.",
+			Expected: false,
+		},
+		"included permission": {
+			Role:       role,
+			Permission: "compute.instances.list",
+			Expected:   true,
+		},
+		"missing permission": {
+			Role:       role,
+			Permission: "compute.instances.delete",
+			Expected:   false,
+		},
+		"prefix and permission": {
+			Role:       role,
+			Prefix:     "roles/compute.",
+			Permission: "compute.instances.get",
+			Expected:   true,
+		},
+		"deleted": {
+			Role:     &iam.Role{Name: "projects/foo/roles/bar", Deleted: true},
+			Expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := filterIamRole(tc.Role, tc.Prefix, tc.Permission); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccDataSourceGoogleIamRoles_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleIamRoles_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleIamRolesMeta("data.google_iam_roles.roles", "roles/compute."),
+					resource.TestCheckResourceAttrSet("data.google_iam_roles.roles", "roles.0.title"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleIamRolesMeta(n, prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find roles data source: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["names.#"])
+		if err != nil {
+			return fmt.Errorf("Error reading the number of roles: %s", err)
+		}
+		if count == 0 {
+			return fmt.Errorf("Expected at least one role")
+		}
+
+		for i := 0; i < count; i++ {
+			name := rs.Primary.Attributes[fmt.Sprintf("names.%d", i)]
+			if !strings.HasPrefix(name, prefix) {
+				return fmt.Errorf("Role %q does not start with %q", name, prefix)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccDataSourceGoogleIamRoles_basic = `
+data "google_iam_roles" "roles" {
+  prefix     = "roles/compute."
+  permission = "compute.instances.list"
+}
+`
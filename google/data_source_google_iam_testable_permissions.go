@@ -0,0 +1,111 @@
+package google
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/iam/v1"
+)
+
+func dataSourceGoogleIamTestablePermissions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleIamTestablePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"full_resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stages": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"ALPHA", "BETA", "GA", "DEPRECATED"}, false),
+				},
+			},
+			"custom_support_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SUPPORTED",
+				ValidateFunc: validation.StringInSlice([]string{"NOT_SUPPORTED", "SUPPORTED", "TESTING"}, false),
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"custom_support_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"only_in_predefined_roles": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleIamTestablePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	fullResourceName := d.Get("full_resource_name").(string)
+	permissions, err := getTestablePermissions(fullResourceName, config)
+	if err != nil {
+		return err
+	}
+
+	stages := make(map[string]struct{})
+	for _, s := range d.Get("stages").([]interface{}) {
+		stages[s.(string)] = struct{}{}
+	}
+	supportLevel := d.Get("custom_support_level").(string)
+
+	d.SetId(fullResourceName)
+	d.Set("permissions", flattenTestablePermissions(permissions, stages, supportLevel))
+
+	return nil
+}
+
+func flattenTestablePermissions(permissions []*iam.Permission, stages map[string]struct{}, supportLevel string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(permissions))
+	for _, p := range permissions {
+		// An empty support level is reported for fully supported permissions.
+		level := p.CustomRolesSupportLevel
+		if level == "" {
+			level = "SUPPORTED"
+		}
+		if level != supportLevel {
+			continue
+		}
+
+		if len(stages) > 0 {
+			if _, ok := stages[p.Stage]; !ok {
+				continue
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":                     p.Name,
+			"title":                    p.Title,
+			"custom_support_level":     level,
+			"stage":                    p.Stage,
+			"only_in_predefined_roles": p.OnlyInPredefinedRoles,
+		})
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceGoogleIamTestablePermissions_basic(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleIamTestablePermissions_basic(project),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleIamTestablePermissionsMeta("data.google_iam_testable_permissions.perms", "SUPPORTED", "GA"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleIamTestablePermissionsMeta(n, supportLevel, stage string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find testable permissions data source: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["permissions.#"])
+		if err != nil {
+			return fmt.Errorf("Error reading the number of permissions: %s", err)
+		}
+		if count == 0 {
+			return fmt.Errorf("Expected at least one testable permission")
+		}
+
+		for i := 0; i < count; i++ {
+			prefix := fmt.Sprintf("permissions.%d.", i)
+			if v := rs.Primary.Attributes[prefix+"custom_support_level"]; v != supportLevel {
+				return fmt.Errorf("Permission %s has support level %q, expected %q", rs.Primary.Attributes[prefix+"name"], v, supportLevel)
+			}
+			if v := rs.Primary.Attributes[prefix+"stage"]; v != stage {
+				return fmt.Errorf("Permission %s has stage %q, expected %q", rs.Primary.Attributes[prefix+"name"], v, stage)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourceGoogleIamTestablePermissions_basic(project string) string {
+	return fmt.Sprintf(`
+data "google_iam_testable_permissions" "perms" {
+  full_resource_name = "//cloudresourcemanager.googleapis.com/projects/%s"
+  stages             = ["GA"]
+}
+`, project)
+}
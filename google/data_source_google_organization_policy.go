@@ -0,0 +1,49 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func dataSourceGoogleOrganizationPolicy() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceGoogleOrganizationPolicy().Schema)
+
+	addRequiredFieldsToSchema(dsSchema, "org_id", "constraint")
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleOrganizationPolicyRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleOrganizationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	org := "organizations/" + d.Get("org_id").(string)
+
+	policy, err := config.clientResourceManager.Organizations.GetEffectiveOrgPolicy(org, &cloudresourcemanager.GetEffectiveOrgPolicyRequest{
+		Constraint: canonicalOrgPolicyConstraint(d.Get("constraint").(string)),
+	}).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading effective organization policy for %s: %s", org, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", d.Get("org_id"), policy.Constraint))
+	setEffectiveOrganizationPolicy(d, policy)
+
+	return nil
+}
+
+// setEffectiveOrganizationPolicy sets the fields shared by the organization
+// policy data sources from an effective policy.
+func setEffectiveOrganizationPolicy(d *schema.ResourceData, policy *cloudresourcemanager.OrgPolicy) {
+	d.Set("constraint", policy.Constraint)
+	d.Set("boolean_policy", flattenBooleanOrganizationPolicy(policy.BooleanPolicy))
+	d.Set("list_policy", flattenListOrganizationPolicy(policy.ListPolicy))
+	d.Set("restore_policy", flattenRestoreOrganizationPolicy(policy.RestoreDefault))
+	d.Set("version", policy.Version)
+	d.Set("etag", policy.Etag)
+	d.Set("update_time", policy.UpdateTime)
+}
@@ -0,0 +1,135 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/net/context"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func dataSourceGoogleOrganizationPolicyConstraints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleOrganizationPolicyConstraintsRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"folder", "project"},
+			},
+			"folder": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"org_id", "project"},
+			},
+			"project": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"org_id", "folder"},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"constraints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"constraint_default": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleOrganizationPolicyConstraintsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	constraints := make([]*cloudresourcemanager.Constraint, 0)
+	appendPage := func(resp *cloudresourcemanager.ListAvailableOrgPolicyConstraintsResponse) error {
+		constraints = append(constraints, resp.Constraints...)
+		return nil
+	}
+
+	req := &cloudresourcemanager.ListAvailableOrgPolicyConstraintsRequest{}
+	var resource string
+	var err error
+	if v, ok := d.GetOk("org_id"); ok {
+		resource = "organizations/" + strings.TrimPrefix(v.(string), "organizations/")
+		err = config.clientResourceManager.Organizations.ListAvailableOrgPolicyConstraints(resource, req).Pages(context.Background(), appendPage)
+	} else if v, ok := d.GetOk("folder"); ok {
+		resource = canonicalFolderId(v.(string))
+		err = config.clientResourceManager.Folders.ListAvailableOrgPolicyConstraints(resource, req).Pages(context.Background(), appendPage)
+	} else {
+		project, perr := getProject(d, config)
+		if perr != nil {
+			return perr
+		}
+		resource = prefixedProject(project)
+		err = config.clientResourceManager.Projects.ListAvailableOrgPolicyConstraints(resource, req).Pages(context.Background(), appendPage)
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing organization policy constraints for %s: %s", resource, err)
+	}
+
+	names := make([]string, 0, len(constraints))
+	for _, c := range constraints {
+		names = append(names, c.Name)
+	}
+
+	d.SetId(resource)
+	d.Set("names", names)
+	d.Set("constraints", flattenOrganizationPolicyConstraints(constraints))
+
+	return nil
+}
+
+func flattenOrganizationPolicyConstraints(constraints []*cloudresourcemanager.Constraint) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(constraints))
+	for _, c := range constraints {
+		constraintType := ""
+		switch {
+		case c.BooleanConstraint != nil:
+			constraintType = "BOOLEAN"
+		case c.ListConstraint != nil:
+			constraintType = "LIST"
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":               c.Name,
+			"display_name":       c.DisplayName,
+			"description":        c.Description,
+			"constraint_default": c.ConstraintDefault,
+			"type":               constraintType,
+			"version":            c.Version,
+		})
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestFlattenOrganizationPolicyConstraints(t *testing.T) {
+	constraints := []*cloudresourcemanager.Constraint{
+		{
+			Name:              "constraints/compute.disableSerialPortAccess",
+			DisplayName:       "Disable VM serial port access",
+			ConstraintDefault: "ALLOW",
+			BooleanConstraint: &cloudresourcemanager.BooleanConstraint{},
+			Version:           1,
+		},
+		{
+			Name:           "constraints/serviceuser.services",
+			ListConstraint: &cloudresourcemanager.ListConstraint{},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"name":               "constraints/compute.disableSerialPortAccess",
+			"display_name":       "Disable VM serial port access",
+			"description":        "",
+			"constraint_default": "ALLOW",
+			"type":               "BOOLEAN",
+			"version":            int64(1),
+		},
+		{
+			"name":               "constraints/serviceuser.services",
+			"display_name":       "",
+			"description":        "",
+			"constraint_default": "",
+			"type":               "LIST",
+			"version":            int64(0),
+		},
+	}
+
+	if got := flattenOrganizationPolicyConstraints(constraints); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %+v, expected %+v", got, expected)
+	}
+}
+
+func TestAccDataSourceGoogleOrganizationPolicyConstraints_project(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+	resourceName := "data.google_organization_policy_constraints.available"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleOrganizationPolicyConstraints_project(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "projects/"+project),
+					resource.TestCheckResourceAttrSet(resourceName, "names.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "constraints.0.name"),
+					resource.TestCheckResourceAttrSet(resourceName, "constraints.0.type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleOrganizationPolicyConstraints_project(project string) string {
+	return fmt.Sprintf(`
+data "google_organization_policy_constraints" "available" {
+  project = "%s"
+}
+`, project)
+}
@@ -0,0 +1,39 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleOrganizationPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	resourceName := "data.google_organization_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleOrganizationPolicy_basic(org),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:constraints/compute.disableSerialPortAccess", org)),
+					resource.TestCheckResourceAttr(resourceName, "constraint", "constraints/compute.disableSerialPortAccess"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleOrganizationPolicy_basic(org string) string {
+	return fmt.Sprintf(`
+data "google_organization_policy" "policy" {
+  org_id     = "%s"
+  constraint = "compute.disableSerialPortAccess"
+}
+`, org)
+}
@@ -0,0 +1,95 @@
+package google
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleProjectAncestry() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleProjectAncestryRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ancestors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"parent": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"folder_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"org_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleProjectAncestryRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	ancestry, err := getResourceAncestry("projects/"+project, config)
+	if err != nil {
+		return err
+	}
+
+	ancestors, folderIds := make([]map[string]interface{}, 0, len(ancestry)), make([]string, 0)
+	parent, orgId := "", ""
+	for i, a := range ancestry {
+		parts := strings.SplitN(a, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		ancestors = append(ancestors, map[string]interface{}{
+			"type": strings.TrimSuffix(parts[0], "s"),
+			"id":   parts[1],
+		})
+
+		if i == 1 {
+			parent = a
+		}
+		switch parts[0] {
+		case "folders":
+			folderIds = append(folderIds, parts[1])
+		case "organizations":
+			orgId = parts[1]
+		}
+	}
+
+	d.SetId(project)
+	d.Set("project", project)
+	d.Set("ancestors", ancestors)
+	d.Set("parent", parent)
+	d.Set("folder_ids", folderIds)
+	d.Set("org_id", orgId)
+
+	return nil
+}
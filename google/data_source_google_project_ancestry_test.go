@@ -0,0 +1,58 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleProjectAncestry_basic(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	pid := "terraform-" + acctest.RandString(10)
+	folderName := "tf-test-" + acctest.RandString(10)
+	resourceName := "data.google_project_ancestry.ancestry"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleProjectAncestry_basic(pid, folderName, org),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ancestors.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "ancestors.0.type", "project"),
+					resource.TestCheckResourceAttr(resourceName, "ancestors.0.id", pid),
+					resource.TestCheckResourceAttr(resourceName, "ancestors.1.type", "folder"),
+					resource.TestCheckResourceAttr(resourceName, "ancestors.2.type", "organization"),
+					resource.TestCheckResourceAttr(resourceName, "ancestors.2.id", org),
+					resource.TestCheckResourceAttrPair(resourceName, "parent", "google_folder.folder", "name"),
+					resource.TestCheckResourceAttr(resourceName, "folder_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "org_id", org),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleProjectAncestry_basic(pid, folderName, org string) string {
+	return fmt.Sprintf(`
+resource "google_folder" "folder" {
+  display_name = "%s"
+  parent       = "organizations/%s"
+}
+
+resource "google_project" "project" {
+  project_id = "%s"
+  name       = "%s"
+  folder_id  = "${google_folder.folder.name}"
+}
+
+data "google_project_ancestry" "ancestry" {
+  project = "${google_project.project.project_id}"
+}
+`, folderName, org, pid, pid)
+}
@@ -0,0 +1,37 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func dataSourceGoogleProjectOrganizationPolicy() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceGoogleProjectOrganizationPolicy().Schema)
+
+	addRequiredFieldsToSchema(dsSchema, "project", "constraint")
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleProjectOrganizationPolicyRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleProjectOrganizationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	project := prefixedProject(d.Get("project").(string))
+
+	policy, err := config.clientResourceManager.Projects.GetEffectiveOrgPolicy(project, &cloudresourcemanager.GetEffectiveOrgPolicyRequest{
+		Constraint: canonicalOrgPolicyConstraint(d.Get("constraint").(string)),
+	}).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading effective organization policy for %s: %s", project, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", d.Get("project"), policy.Constraint))
+	setEffectiveOrganizationPolicy(d, policy)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleProjectOrganizationPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+	resourceName := "data.google_project_organization_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleProjectOrganizationPolicy_basic(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:constraints/serviceuser.services", project)),
+					resource.TestCheckResourceAttr(resourceName, "constraint", "constraints/serviceuser.services"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleProjectOrganizationPolicy_basic(project string) string {
+	return fmt.Sprintf(`
+data "google_project_organization_policy" "policy" {
+  project    = "%s"
+  constraint = "constraints/serviceuser.services"
+}
+`, project)
+}
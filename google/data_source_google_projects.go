@@ -0,0 +1,95 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/net/context"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func dataSourceGoogleProjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleProjectsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleProjectsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	filter := d.Get("filter").(string)
+	projects := make([]*cloudresourcemanager.Project, 0)
+
+	err := config.clientResourceManager.Projects.List().Filter(filter).
+		Pages(context.Background(), func(resp *cloudresourcemanager.ListProjectsResponse) error {
+			projects = append(projects, resp.Projects...)
+			return nil
+		})
+	if err != nil {
+		return fmt.Errorf("Error listing projects with filter %q: %s", filter, err)
+	}
+
+	d.SetId(filter)
+	d.Set("projects", flattenDatasourceGoogleProjectsList(projects))
+
+	return nil
+}
+
+func flattenDatasourceGoogleProjectsList(v []*cloudresourcemanager.Project) []map[string]interface{} {
+	projects := make([]map[string]interface{}, 0, len(v))
+	for _, p := range v {
+		parent := ""
+		if p.Parent != nil {
+			parent = fmt.Sprintf("%ss/%s", p.Parent.Type, p.Parent.Id)
+		}
+
+		projects = append(projects, map[string]interface{}{
+			"project_id":      p.ProjectId,
+			"number":          fmt.Sprintf("%d", p.ProjectNumber),
+			"name":            p.Name,
+			"lifecycle_state": p.LifecycleState,
+			"parent":          parent,
+			"labels":          p.Labels,
+		})
+	}
+	return projects
+}
@@ -0,0 +1,81 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestFlattenDatasourceGoogleProjectsList(t *testing.T) {
+	projects := []*cloudresourcemanager.Project{
+		{
+			ProjectId:      "my-project",
+			ProjectNumber:  123456789012,
+			Name:           "My Project",
+			LifecycleState: "ACTIVE",
+			Parent:         &cloudresourcemanager.ResourceId{Type: "folder", Id: "456"},
+			Labels:         map[string]string{"env": "prod"},
+		},
+		{
+			ProjectId:      "orphan",
+			ProjectNumber:  42,
+			LifecycleState: "DELETE_REQUESTED",
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"project_id":      "my-project",
+			"number":          "123456789012",
+			"name":            "My Project",
+			"lifecycle_state": "ACTIVE",
+			"parent":          "folders/456",
+			"labels":          map[string]string{"env": "prod"},
+		},
+		{
+			"project_id":      "orphan",
+			"number":          "42",
+			"name":            "",
+			"lifecycle_state": "DELETE_REQUESTED",
+			"parent":          "",
+			"labels":          map[string]string(nil),
+		},
+	}
+
+	if got := flattenDatasourceGoogleProjectsList(projects); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %+v, expected %+v", got, expected)
+	}
+}
+
+func TestAccDataSourceGoogleProjects_basic(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleProjects_basic(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_projects.my-project", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.google_projects.my-project", "projects.0.project_id", project),
+					resource.TestCheckResourceAttr("data.google_projects.my-project", "projects.0.lifecycle_state", "ACTIVE"),
+					resource.TestCheckResourceAttrSet("data.google_projects.my-project", "projects.0.number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleProjects_basic(project string) string {
+	return fmt.Sprintf(`
+data "google_projects" "my-project" {
+  filter = "id:%s lifecycleState:ACTIVE"
+}
+`, project)
+}
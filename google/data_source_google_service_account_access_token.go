@@ -0,0 +1,95 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const iamCredentialsBasePath = "https://iamcredentials.googleapis.com/v1/"
+
+func dataSourceGoogleServiceAccountAccessToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleServiceAccountAccessTokenRead,
+		Schema: map[string]*schema.Schema{
+			"target_service_account": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scopes": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delegates": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"lifetime": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "3600s",
+				ValidateFunc: validateRegexp(`^[0-9]+(\.[0-9]{1,9})?s$`),
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"access_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleServiceAccountAccessTokenRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	name, err := serviceAccountFQN(d.Get("target_service_account").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	delegates, err := expandServiceAccountDelegates(d.Get("delegates").([]interface{}), d, config)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"scope":     canonicalizeServiceScopes(convertStringArr(d.Get("scopes").([]interface{}))),
+		"lifetime":  d.Get("lifetime").(string),
+		"delegates": delegates,
+	}
+
+	res, err := sendRequest(config, "POST", iamCredentialsBasePath+name+":generateAccessToken", body)
+	if err != nil {
+		return fmt.Errorf("Error generating access token for %s: %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("access_token", res["accessToken"])
+	d.Set("expire_time", res["expireTime"])
+
+	return nil
+}
+
+// expandServiceAccountDelegates returns the fully qualified names of the
+// delegation chain, given as emails, account ids or fully qualified names.
+func expandServiceAccountDelegates(v []interface{}, d TerraformResourceData, config *Config) ([]string, error) {
+	delegates := make([]string, 0, len(v))
+	for _, delegate := range v {
+		name, err := serviceAccountFQN(delegate.(string), d, config)
+		if err != nil {
+			return nil, err
+		}
+		delegates = append(delegates, name)
+	}
+	return delegates, nil
+}
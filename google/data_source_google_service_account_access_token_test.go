@@ -0,0 +1,52 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleServiceAccountAccessToken_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.google_service_account_access_token.default"
+	serviceAccount := getTestServiceAccountFromEnv(t)
+	account := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleServiceAccountAccessToken(account, serviceAccount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "access_token"),
+					resource.TestCheckResourceAttrSet(resourceName, "expire_time"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.0", "cloud-platform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleServiceAccountAccessToken(account, serviceAccount string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "target" {
+  account_id = "%s"
+}
+
+resource "google_service_account_iam_member" "token_creator" {
+  service_account_id = "${google_service_account.target.name}"
+  role               = "roles/iam.serviceAccountTokenCreator"
+  member             = "serviceAccount:%s"
+}
+
+data "google_service_account_access_token" "default" {
+  target_service_account = "${google_service_account_iam_member.token_creator.service_account_id}"
+  scopes                 = ["cloud-platform"]
+  lifetime               = "300s"
+}
+`, account, serviceAccount)
+}
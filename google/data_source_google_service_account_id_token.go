@@ -0,0 +1,72 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleServiceAccountIdToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleServiceAccountIdTokenRead,
+		Schema: map[string]*schema.Schema{
+			"target_service_account": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_audience": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"include_email": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delegates": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"id_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleServiceAccountIdTokenRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	name, err := serviceAccountFQN(d.Get("target_service_account").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	delegates, err := expandServiceAccountDelegates(d.Get("delegates").([]interface{}), d, config)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"audience":     d.Get("target_audience").(string),
+		"includeEmail": d.Get("include_email").(bool),
+		"delegates":    delegates,
+	}
+
+	res, err := sendRequest(config, "POST", iamCredentialsBasePath+name+":generateIdToken", body)
+	if err != nil {
+		return fmt.Errorf("Error generating ID token for %s: %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("id_token", res["token"])
+
+	return nil
+}
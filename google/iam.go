@@ -3,9 +3,11 @@ package google
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
@@ -154,3 +156,92 @@ func rolesToMembersMap(bindings []*cloudresourcemanager.Binding) map[string]map[
 	}
 	return bm
 }
+
+// Members of principals that were deleted are returned by the API as
+// "deleted:{type}:{email}?uid={uniqueId}", e.g.
+// "deleted:serviceAccount:my-sa@my-project.iam.gserviceaccount.com?uid=123".
+const iamDeletedMemberPrefix = "deleted:"
+
+// The canonical casing of the member types that are followed by an email
+// address or a domain, keyed by their lowercase form.
+var iamMemberTypes = map[string]string{
+	"user":           "user",
+	"serviceaccount": "serviceAccount",
+	"group":          "group",
+	"domain":         "domain",
+	"deleted":        "deleted",
+}
+
+// Returns the member in the casing the API uses: member types are
+// canonicalized and email addresses and domains, which are case insensitive,
+// are lowercased. Members that aren't recognized are returned unchanged.
+func normalizeIamMember(member string) string {
+	pieces := strings.SplitN(member, ":", 2)
+	if len(pieces) != 2 {
+		return member
+	}
+
+	memberType, ok := iamMemberTypes[strings.ToLower(pieces[0])]
+	if !ok {
+		return member
+	}
+
+	if memberType == "deleted" {
+		// The uid suffix of a deleted member is kept as is.
+		id := pieces[1]
+		suffix := ""
+		if i := strings.Index(id, "?"); i >= 0 {
+			id, suffix = id[:i], id[i:]
+		}
+		return memberType + ":" + normalizeIamMember(id) + suffix
+	}
+
+	return memberType + ":" + strings.ToLower(pieces[1])
+}
+
+func isDeletedIamMember(member string) bool {
+	return strings.HasPrefix(strings.ToLower(member), iamDeletedMemberPrefix)
+}
+
+// Returns a copy of the bindings with normalized members. If stripDeleted is
+// set, members of deleted principals are removed, along with any binding left
+// without members.
+func normalizeIamBindings(bindings []*cloudresourcemanager.Binding, stripDeleted bool) []*cloudresourcemanager.Binding {
+	result := make([]*cloudresourcemanager.Binding, 0, len(bindings))
+	for _, b := range bindings {
+		members := normalizeIamMembers(b.Members, stripDeleted)
+		if stripDeleted && len(members) == 0 {
+			continue
+		}
+		result = append(result, &cloudresourcemanager.Binding{
+			Role:    b.Role,
+			Members: members,
+		})
+	}
+	return result
+}
+
+func normalizeIamMembers(members []string, stripDeleted bool) []string {
+	result := make([]string, 0, len(members))
+	for _, m := range members {
+		if stripDeleted && isDeletedIamMember(m) {
+			continue
+		}
+		result = append(result, normalizeIamMember(m))
+	}
+	return result
+}
+
+func iamMemberStateFunc(v interface{}) string {
+	return normalizeIamMember(v.(string))
+}
+
+func iamMemberDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeIamMember(old) == normalizeIamMember(new)
+}
+
+// Hashes members by their normalized value so that members differing only in
+// casing are treated as the same set element.
+func hashIamMember(v interface{}) int {
+	return hashcode.String(normalizeIamMember(v.(string)))
+}
@@ -0,0 +1,90 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamBillingAccountSchema = map[string]*schema.Schema{
+	"billing_account_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+}
+
+// The vendored cloudbilling client predates the billing account IAM methods,
+// so the policy is read and written through sendRequest against the base path
+// of the clientBilling service. Billing account policies have the same
+// structure as Resource Manager policies.
+type BillingAccountIamUpdater struct {
+	billingAccountId string
+	Config           *Config
+}
+
+func NewBillingAccountIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	return &BillingAccountIamUpdater{
+		billingAccountId: canonicalBillingAccountId(d.Get("billing_account_id").(string)),
+		Config:           config,
+	}, nil
+}
+
+func BillingAccountIdParseFunc(d *schema.ResourceData, _ *Config) error {
+	d.Set("billing_account_id", canonicalBillingAccountId(d.Id()))
+	return nil
+}
+
+func (u *BillingAccountIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	res, err := sendRequest(u.Config, "GET", u.iamPolicyUrl("getIamPolicy"), nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	p := &cloudresourcemanager.Policy{}
+	if err := Convert(res, p); err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Cannot convert the IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return p, nil
+}
+
+func (u *BillingAccountIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	obj := make(map[string]interface{})
+	if err := Convert(policy, &obj); err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Cannot convert the IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	_, err := sendRequest(u.Config, "POST", u.iamPolicyUrl("setIamPolicy"), map[string]interface{}{
+		"policy": obj,
+	})
+
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *BillingAccountIamUpdater) GetResourceId() string {
+	return u.billingAccountId
+}
+
+func (u *BillingAccountIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-billing-account-%s", u.billingAccountId)
+}
+
+func (u *BillingAccountIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("billing account %q", u.billingAccountId)
+}
+
+func (u *BillingAccountIamUpdater) iamPolicyUrl(method string) string {
+	return fmt.Sprintf("%sv1/billingAccounts/%s:%s", u.Config.clientBilling.BasePath, u.billingAccountId, method)
+}
+
+func canonicalBillingAccountId(billingAccount string) string {
+	return strings.TrimPrefix(billingAccount, "billingAccounts/")
+}
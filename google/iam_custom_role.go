@@ -0,0 +1,130 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/net/context"
+	"google.golang.org/api/iam/v1"
+)
+
+const resourceManagerFullResourceNamePrefix = "//cloudresourcemanager.googleapis.com/"
+
+// getTestablePermissions returns every permission that can be tested on the
+// resource identified by fullResourceName, e.g.
+// "//cloudresourcemanager.googleapis.com/projects/my-project".
+func getTestablePermissions(fullResourceName string, config *Config) ([]*iam.Permission, error) {
+	var permissions []*iam.Permission
+
+	err := retry(func() error {
+		// Reset the list in case of a retry, a partial page failure could
+		// result in duplicate permissions.
+		permissions = make([]*iam.Permission, 0)
+
+		req := &iam.QueryTestablePermissionsRequest{
+			FullResourceName: fullResourceName,
+			PageSize:         1000,
+		}
+		return config.clientIAM.Permissions.QueryTestablePermissions(req).
+			Pages(context.Background(), func(r *iam.QueryTestablePermissionsResponse) error {
+				permissions = append(permissions, r.Permissions...)
+				return nil
+			})
+	})
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error listing testable permissions for %s: {{err}}", fullResourceName), err)
+	}
+
+	return permissions, nil
+}
+
+// checkCustomRolePermissions verifies that every requested permission exists on
+// the resource and can be included in a custom role there.
+func checkCustomRolePermissions(fullResourceName string, testable []*iam.Permission, requested []string) error {
+	byName := make(map[string]*iam.Permission, len(testable))
+	for _, p := range testable {
+		byName[p.Name] = p
+	}
+
+	sorted := make([]string, len(requested))
+	copy(sorted, requested)
+	sort.Strings(sorted)
+
+	var errs []string
+	for _, name := range sorted {
+		p, ok := byName[name]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%q is not a known permission for %s", name, fullResourceName))
+			continue
+		}
+
+		if p.OnlyInPredefinedRoles {
+			errs = append(errs, fmt.Sprintf("%q can only be used in predefined roles", name))
+			continue
+		}
+
+		switch p.CustomRolesSupportLevel {
+		case "NOT_SUPPORTED":
+			errs = append(errs, fmt.Sprintf("%q is not supported in custom roles", name))
+		case "TESTING":
+			errs = append(errs, fmt.Sprintf("%q is still being tested for custom role compatibility (TESTING)", name))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("Invalid permissions for a custom role on %s:\n  %s", fullResourceName, strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// customRolePermissionsCustomizeDiff returns a CustomizeDiff function that
+// validates the role's permissions against the testable permissions of the
+// resource returned by fullResourceNameFunc. Validation is skipped while the
+// permissions or the parent resource are not yet known.
+func customRolePermissionsCustomizeDiff(fullResourceNameFunc func(*schema.ResourceDiff, *Config) (string, error)) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.HasChange("permissions") || !diff.NewValueKnown("permissions") {
+			return nil
+		}
+
+		config := meta.(*Config)
+		fullResourceName, err := fullResourceNameFunc(diff, config)
+		if err != nil || fullResourceName == "" {
+			return err
+		}
+
+		testable, err := getTestablePermissions(fullResourceName, config)
+		if err != nil {
+			return err
+		}
+
+		return checkCustomRolePermissions(fullResourceName, testable, convertStringSet(diff.Get("permissions").(*schema.Set)))
+	}
+}
+
+func projectCustomRoleFullResourceName(diff *schema.ResourceDiff, config *Config) (string, error) {
+	if !diff.NewValueKnown("project") {
+		return "", nil
+	}
+
+	project := config.Project
+	if v, ok := diff.GetOk("project"); ok {
+		project = v.(string)
+	}
+	if project == "" {
+		return "", fmt.Errorf("project: required field is not set")
+	}
+
+	return resourceManagerFullResourceNamePrefix + "projects/" + project, nil
+}
+
+func organizationCustomRoleFullResourceName(diff *schema.ResourceDiff, config *Config) (string, error) {
+	if !diff.NewValueKnown("org_id") {
+		return "", nil
+	}
+
+	return resourceManagerFullResourceNamePrefix + "organizations/" + diff.Get("org_id").(string), nil
+}
@@ -0,0 +1,76 @@
+package google
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/api/iam/v1"
+)
+
+func TestCheckCustomRolePermissions(t *testing.T) {
+	testable := []*iam.Permission{
+		{Name: "iam.roles.list", Stage: "GA"},
+		{Name: "iam.roles.create", Stage: "GA", CustomRolesSupportLevel: "SUPPORTED"},
+		{Name: "compute.instances.list", Stage: "BETA", CustomRolesSupportLevel: "TESTING"},
+		{Name: "resourcemanager.projects.list", Stage: "GA", CustomRolesSupportLevel: "NOT_SUPPORTED"},
+		{Name: "iam.serviceAccounts.actAs", Stage: "GA", OnlyInPredefinedRoles: true},
+	}
+
+	cases := map[string]struct {
+		Requested []string
+		Errors    []string
+	}{
+		"supported": {
+			Requested: []string{"iam.roles.list", "iam.roles.create"},
+		},
+		"unknown": {
+			Requested: []string{"iam.roles.list", "iam.roles.bogus"},
+			Errors:    []string{`"iam.roles.bogus" is not a known permission`},
+		},
+		"testing": {
+			Requested: []string{"compute.instances.list"},
+			Errors:    []string{`"compute.instances.list" is still being tested`},
+		},
+		"not supported": {
+			Requested: []string{"resourcemanager.projects.list"},
+			Errors:    []string{`"resourcemanager.projects.list" is not supported in custom roles`},
+		},
+		"predefined only": {
+			Requested: []string{"iam.serviceAccounts.actAs"},
+			Errors:    []string{`"iam.serviceAccounts.actAs" can only be used in predefined roles`},
+		},
+		"multiple": {
+			Requested: []string{"iam.roles.bogus", "compute.instances.list", "iam.roles.list"},
+			Errors: []string{
+				`"compute.instances.list" is still being tested`,
+				`"iam.roles.bogus" is not a known permission`,
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		err := checkCustomRolePermissions("//cloudresourcemanager.googleapis.com/projects/foo", testable, tc.Requested)
+		if len(tc.Errors) == 0 {
+			if err != nil {
+				t.Errorf("%s: expected no error, got %s", tn, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("%s: expected an error, got none", tn)
+			continue
+		}
+
+		last := -1
+		for _, msg := range tc.Errors {
+			i := strings.Index(err.Error(), msg)
+			if i < 0 {
+				t.Errorf("%s: expected error to contain %q, got %s", tn, msg, err)
+			} else if i < last {
+				t.Errorf("%s: expected errors to be sorted by permission, got %s", tn, err)
+			}
+			last = i
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestNormalizeIamMember(t *testing.T) {
+	table := []struct {
+		input  string
+		expect string
+	}{
+		{"user:jane@example.com", "user:jane@example.com"},
+		{"User:Jane@Example.com", "user:jane@example.com"},
+		{"serviceaccount:My-SA@my-project.iam.gserviceaccount.com", "serviceAccount:my-sa@my-project.iam.gserviceaccount.com"},
+		{"GROUP:Admins@example.com", "group:admins@example.com"},
+		{"domain:Example.com", "domain:example.com"},
+		{"allUsers", "allUsers"},
+		{"allAuthenticatedUsers", "allAuthenticatedUsers"},
+		{"projectOwner:My-Project", "projectOwner:My-Project"},
+		{
+			"deleted:serviceAccount:My-SA@my-project.iam.gserviceaccount.com?uid=123456789ABC",
+			"deleted:serviceAccount:my-sa@my-project.iam.gserviceaccount.com?uid=123456789ABC",
+		},
+		{
+			"Deleted:User:Jane@example.com?uid=123",
+			"deleted:user:jane@example.com?uid=123",
+		},
+	}
+
+	for _, test := range table {
+		if got := normalizeIamMember(test.input); got != test.expect {
+			t.Errorf("normalizeIamMember(%q) = %q, expected %q", test.input, got, test.expect)
+		}
+	}
+}
+
+func TestNormalizeIamBindings(t *testing.T) {
+	bindings := []*cloudresourcemanager.Binding{
+		{
+			Role: "roles/viewer",
+			Members: []string{
+				"User:Jane@example.com",
+				"deleted:serviceAccount:sa@my-project.iam.gserviceaccount.com?uid=123",
+			},
+		},
+		{
+			Role: "roles/editor",
+			Members: []string{
+				"deleted:serviceAccount:sa@my-project.iam.gserviceaccount.com?uid=123",
+			},
+		},
+	}
+
+	table := []struct {
+		stripDeleted bool
+		expect       []*cloudresourcemanager.Binding
+	}{
+		{
+			stripDeleted: false,
+			expect: []*cloudresourcemanager.Binding{
+				{
+					Role: "roles/viewer",
+					Members: []string{
+						"user:jane@example.com",
+						"deleted:serviceAccount:sa@my-project.iam.gserviceaccount.com?uid=123",
+					},
+				},
+				{
+					Role: "roles/editor",
+					Members: []string{
+						"deleted:serviceAccount:sa@my-project.iam.gserviceaccount.com?uid=123",
+					},
+				},
+			},
+		},
+		{
+			stripDeleted: true,
+			expect: []*cloudresourcemanager.Binding{
+				{
+					Role:    "roles/viewer",
+					Members: []string{"user:jane@example.com"},
+				},
+			},
+		},
+	}
+
+	for _, test := range table {
+		got := normalizeIamBindings(bindings, test.stripDeleted)
+		if !reflect.DeepEqual(got, test.expect) {
+			t.Errorf("normalizeIamBindings(stripDeleted=%t) = %+v, expected %+v", test.stripDeleted, got, test.expect)
+		}
+	}
+
+	// The input bindings must not be modified.
+	if bindings[0].Members[0] != "User:Jane@example.com" || len(bindings) != 2 {
+		t.Errorf("normalizeIamBindings modified its input: %+v", bindings)
+	}
+}
+
+func TestStripDeletedIamMembersFromRole(t *testing.T) {
+	deleted := "deleted:serviceAccount:sa@my-project.iam.gserviceaccount.com?uid=123"
+	bindings := []*cloudresourcemanager.Binding{
+		{Role: "roles/viewer", Members: []string{"user:jane@example.com", deleted}},
+		{Role: "roles/editor", Members: []string{deleted}},
+		{Role: "roles/owner", Members: []string{deleted}},
+	}
+
+	got := stripDeletedIamMembersFromRole(bindings, "roles/viewer")
+	expect := []*cloudresourcemanager.Binding{
+		{Role: "roles/viewer", Members: []string{"user:jane@example.com"}},
+		{Role: "roles/editor", Members: []string{deleted}},
+		{Role: "roles/owner", Members: []string{deleted}},
+	}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("got %+v, expected %+v", got, expect)
+	}
+
+	got = stripDeletedIamMembersFromRole(bindings, "roles/editor")
+	expect = []*cloudresourcemanager.Binding{
+		{Role: "roles/viewer", Members: []string{"user:jane@example.com", deleted}},
+		{Role: "roles/owner", Members: []string{deleted}},
+	}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("got %+v, expected %+v", got, expect)
+	}
+}
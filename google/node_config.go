@@ -127,6 +127,8 @@ var schemaNodeConfig = &schema.Schema{
 				Default:  false,
 			},
 
+			"reservation_affinity": schemaReservationAffinity(),
+
 			"service_account": {
 				Type:     schema.TypeString,
 				Optional: true,
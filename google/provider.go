@@ -25,7 +25,17 @@ func Provider() terraform.ResourceProvider {
 					"GOOGLE_CLOUD_KEYFILE_JSON",
 					"GCLOUD_KEYFILE_JSON",
 				}, nil),
-				ValidateFunc: validateCredentials,
+				ValidateFunc:  validateCredentials,
+				ConflictsWith: []string{"access_token"},
+			},
+
+			"access_token": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_OAUTH_ACCESS_TOKEN",
+				}, nil),
+				ConflictsWith: []string{"credentials"},
 			},
 
 			"project": &schema.Schema{
@@ -73,6 +83,9 @@ func Provider() terraform.ResourceProvider {
 			"google_compute_lb_ip_ranges":            dataSourceGoogleComputeLbIpRanges(),
 			"google_compute_network":                 dataSourceGoogleComputeNetwork(),
 			"google_project":                         dataSourceGoogleProject(),
+			"google_projects":                        dataSourceGoogleProjects(),
+			"google_project_ancestry":                dataSourceGoogleProjectAncestry(),
+			"google_project_organization_policy":     dataSourceGoogleProjectOrganizationPolicy(),
 			"google_compute_subnetwork":              dataSourceGoogleComputeSubnetwork(),
 			"google_compute_zones":                   dataSourceGoogleComputeZones(),
 			"google_compute_instance_group":          dataSourceGoogleComputeInstanceGroup(),
@@ -84,12 +97,21 @@ func Provider() terraform.ResourceProvider {
 			"google_container_engine_versions":       dataSourceGoogleContainerEngineVersions(),
 			"google_container_registry_repository":   dataSourceGoogleContainerRepo(),
 			"google_container_registry_image":        dataSourceGoogleContainerImage(),
+			"google_iam_effective_policy":            dataSourceGoogleIamEffectivePolicy(),
 			"google_iam_policy":                      dataSourceGoogleIamPolicy(),
+			"google_iam_role":                        dataSourceGoogleIamRole(),
+			"google_iam_roles":                       dataSourceGoogleIamRoles(),
+			"google_iam_testable_permissions":        dataSourceGoogleIamTestablePermissions(),
 			"google_kms_secret":                      dataSourceGoogleKmsSecret(),
 			"google_folder":                          dataSourceGoogleFolder(),
+			"google_folder_organization_policy":      dataSourceGoogleFolderOrganizationPolicy(),
 			"google_netblock_ip_ranges":              dataSourceGoogleNetblockIpRanges(),
 			"google_organization":                    dataSourceGoogleOrganization(),
+			"google_organization_policy":             dataSourceGoogleOrganizationPolicy(),
+			"google_organization_policy_constraints": dataSourceGoogleOrganizationPolicyConstraints(),
 			"google_service_account":                 dataSourceGoogleServiceAccount(),
+			"google_service_account_access_token":    dataSourceGoogleServiceAccountAccessToken(),
+			"google_service_account_id_token":        dataSourceGoogleServiceAccountIdToken(),
 			"google_service_account_key":             dataSourceGoogleServiceAccountKey(),
 			"google_storage_object_signed_url":       dataSourceGoogleSignedUrl(),
 			"google_storage_project_service_account": dataSourceGoogleStorageProjectServiceAccount(),
@@ -98,6 +120,8 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: mergeResourceMaps(
+			GeneratedAccessContextManagerResourcesMap,
+			GeneratedBillingResourcesMap,
 			GeneratedComputeResourcesMap,
 			GeneratedRedisResourcesMap,
 			GeneratedResourceManagerResourcesMap,
@@ -106,6 +130,9 @@ func Provider() terraform.ResourceProvider {
 				"google_bigquery_table":                        resourceBigQueryTable(),
 				"google_bigtable_instance":                     resourceBigtableInstance(),
 				"google_bigtable_table":                        resourceBigtableTable(),
+				"google_billing_account_iam_binding":           ResourceIamBindingWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+				"google_billing_account_iam_member":            ResourceIamMemberWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+				"google_billing_account_iam_policy":            ResourceIamPolicyWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
 				"google_cloudbuild_trigger":                    resourceCloudBuildTrigger(),
 				"google_cloudfunctions_function":               resourceCloudFunctionsFunction(),
 				"google_cloudiot_registry":                     resourceCloudIoTRegistry(),
@@ -125,16 +152,20 @@ func Provider() terraform.ResourceProvider {
 				"google_compute_instance_group_manager":        resourceComputeInstanceGroupManager(),
 				"google_compute_instance_template":             resourceComputeInstanceTemplate(),
 				"google_compute_network":                       resourceComputeNetwork(),
+				"google_compute_network_endpoint":              resourceComputeNetworkEndpoint(),
 				"google_compute_network_peering":               resourceComputeNetworkPeering(),
+				"google_compute_per_instance_config":           resourceComputePerInstanceConfig(),
 				"google_compute_project_metadata":              resourceComputeProjectMetadata(),
 				"google_compute_project_metadata_item":         resourceComputeProjectMetadataItem(),
 				"google_compute_region_autoscaler":             resourceComputeRegionAutoscaler(),
 				"google_compute_region_backend_service":        resourceComputeRegionBackendService(),
 				"google_compute_region_instance_group_manager": resourceComputeRegionInstanceGroupManager(),
+				"google_compute_region_per_instance_config":    resourceComputeRegionPerInstanceConfig(),
 				"google_compute_route":                         resourceComputeRoute(),
 				"google_compute_router":                        resourceComputeRouter(),
 				"google_compute_router_interface":              resourceComputeRouterInterface(),
 				"google_compute_router_peer":                   resourceComputeRouterPeer(),
+				"google_compute_router_nat":                    resourceComputeRouterNat(),
 				"google_compute_security_policy":               resourceComputeSecurityPolicy(),
 				"google_compute_shared_vpc_host_project":       resourceComputeSharedVpcHostProject(),
 				"google_compute_shared_vpc_service_project":    resourceComputeSharedVpcServiceProject(),
@@ -196,6 +227,7 @@ func Provider() terraform.ResourceProvider {
 				"google_organization_iam_policy":               ResourceIamPolicyWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
 				"google_organization_policy":                   resourceGoogleOrganizationPolicy(),
 				"google_project":                               resourceGoogleProject(),
+				"google_project_default_service_accounts":      resourceGoogleProjectDefaultServiceAccounts(),
 				"google_project_iam_policy":                    resourceGoogleProjectIamPolicy(),
 				"google_project_iam_binding":                   ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
 				"google_project_iam_member":                    ResourceIamMemberWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
@@ -242,6 +274,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	credentials := d.Get("credentials").(string)
 	config := Config{
 		Credentials: credentials,
+		AccessToken: d.Get("access_token").(string),
 		Project:     d.Get("project").(string),
 		Region:      d.Get("region").(string),
 		Zone:        d.Get("zone").(string),
@@ -0,0 +1,23 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+var GeneratedAccessContextManagerResourcesMap = map[string]*schema.Resource{
+	"google_access_context_manager_access_policy":     resourceAccessContextManagerAccessPolicy(),
+	"google_access_context_manager_access_level":      resourceAccessContextManagerAccessLevel(),
+	"google_access_context_manager_service_perimeter": resourceAccessContextManagerServicePerimeter(),
+}
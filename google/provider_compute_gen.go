@@ -17,24 +17,33 @@ package google
 import "github.com/hashicorp/terraform/helper/schema"
 
 var GeneratedComputeResourcesMap = map[string]*schema.Resource{
-	"google_compute_address":            resourceComputeAddress(),
-	"google_compute_autoscaler":         resourceComputeAutoscaler(),
-	"google_compute_backend_bucket":     resourceComputeBackendBucket(),
-	"google_compute_disk":               resourceComputeDisk(),
-	"google_compute_forwarding_rule":    resourceComputeForwardingRule(),
-	"google_compute_global_address":     resourceComputeGlobalAddress(),
-	"google_compute_http_health_check":  resourceComputeHttpHealthCheck(),
-	"google_compute_https_health_check": resourceComputeHttpsHealthCheck(),
-	"google_compute_region_autoscaler":  resourceComputeRegionAutoscaler(),
-	"google_compute_region_disk":        resourceComputeRegionDisk(),
-	"google_compute_route":              resourceComputeRoute(),
-	"google_compute_router":             resourceComputeRouter(),
-	"google_compute_ssl_policy":         resourceComputeSslPolicy(),
-	"google_compute_subnetwork":         resourceComputeSubnetwork(),
-	"google_compute_target_http_proxy":  resourceComputeTargetHttpProxy(),
-	"google_compute_target_https_proxy": resourceComputeTargetHttpsProxy(),
-	"google_compute_target_ssl_proxy":   resourceComputeTargetSslProxy(),
-	"google_compute_target_tcp_proxy":   resourceComputeTargetTcpProxy(),
-	"google_compute_vpn_gateway":        resourceComputeVpnGateway(),
-	"google_compute_vpn_tunnel":         resourceComputeVpnTunnel(),
+	"google_compute_address":                 resourceComputeAddress(),
+	"google_compute_autoscaler":              resourceComputeAutoscaler(),
+	"google_compute_backend_bucket":          resourceComputeBackendBucket(),
+	"google_compute_disk":                    resourceComputeDisk(),
+	"google_compute_external_vpn_gateway":    resourceComputeExternalVpnGateway(),
+	"google_compute_forwarding_rule":         resourceComputeForwardingRule(),
+	"google_compute_global_address":          resourceComputeGlobalAddress(),
+	"google_compute_ha_vpn_gateway":          resourceComputeHaVpnGateway(),
+	"google_compute_http_health_check":       resourceComputeHttpHealthCheck(),
+	"google_compute_https_health_check":      resourceComputeHttpsHealthCheck(),
+	"google_compute_managed_ssl_certificate": resourceComputeManagedSslCertificate(),
+	"google_compute_network_endpoint_group":  resourceComputeNetworkEndpointGroup(),
+	"google_compute_node_group":              resourceComputeNodeGroup(),
+	"google_compute_node_template":           resourceComputeNodeTemplate(),
+	"google_compute_region_autoscaler":       resourceComputeRegionAutoscaler(),
+	"google_compute_region_commitment":       resourceComputeRegionCommitment(),
+	"google_compute_region_disk":             resourceComputeRegionDisk(),
+	"google_compute_reservation":             resourceComputeReservation(),
+	"google_compute_resource_policy":         resourceComputeResourcePolicy(),
+	"google_compute_route":                   resourceComputeRoute(),
+	"google_compute_router":                  resourceComputeRouter(),
+	"google_compute_ssl_policy":              resourceComputeSslPolicy(),
+	"google_compute_subnetwork":              resourceComputeSubnetwork(),
+	"google_compute_target_http_proxy":       resourceComputeTargetHttpProxy(),
+	"google_compute_target_https_proxy":      resourceComputeTargetHttpsProxy(),
+	"google_compute_target_ssl_proxy":        resourceComputeTargetSslProxy(),
+	"google_compute_target_tcp_proxy":        resourceComputeTargetTcpProxy(),
+	"google_compute_vpn_gateway":             resourceComputeVpnGateway(),
+	"google_compute_vpn_tunnel":              resourceComputeVpnTunnel(),
 }
@@ -0,0 +1,263 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+	containerBeta "google.golang.org/api/container/v1beta1"
+)
+
+// The vendored compute and container clients predate reservation affinities,
+// so resources that have one are sent as raw JSON with the affinity added, and
+// the affinity is read back from their raw JSON.
+
+func schemaReservationAffinity() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice([]string{"ANY_RESERVATION", "SPECIFIC_RESERVATION", "NO_RESERVATION"}, false),
+				},
+				"specific_reservation": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"values": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandReservationAffinity returns the reservationAffinity for the
+// reservation_affinity block in v, or nil if there's none.
+func expandReservationAffinity(v interface{}) map[string]interface{} {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	data := l[0].(map[string]interface{})
+
+	affinity := map[string]interface{}{
+		"consumeReservationType": data["type"],
+	}
+	if specific, ok := data["specific_reservation"].([]interface{}); ok && len(specific) > 0 && specific[0] != nil {
+		reservation := specific[0].(map[string]interface{})
+		affinity["key"] = reservation["key"]
+		affinity["values"] = reservation["values"]
+	}
+	return affinity
+}
+
+func flattenReservationAffinity(v interface{}) []map[string]interface{} {
+	affinity, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	transformed := map[string]interface{}{
+		"type": affinity["consumeReservationType"],
+	}
+	if key, ok := affinity["key"]; ok {
+		transformed["specific_reservation"] = []map[string]interface{}{
+			{
+				"key":    key,
+				"values": affinity["values"],
+			},
+		}
+	}
+	return []map[string]interface{}{transformed}
+}
+
+// toRawJSON converts a request of the compute or container clients to a
+// raw JSON object. Unlike Convert, it keeps the fields the client forces to
+// be sent.
+func toRawJSON(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	obj := make(map[string]interface{})
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// rawJSONObject returns the object at path in obj, creating the missing
+// objects along the way.
+func rawJSONObject(obj map[string]interface{}, path ...string) (map[string]interface{}, error) {
+	for _, p := range path {
+		if obj[p] == nil {
+			obj[p] = make(map[string]interface{})
+		}
+		next, ok := obj[p].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%q isn't an object", p)
+		}
+		obj = next
+	}
+	return obj, nil
+}
+
+// insertComputeWithReservationAffinity inserts the compute resource at url,
+// with the reservation affinity set on the object at path in the resource.
+func insertComputeWithReservationAffinity(config *Config, url string, resource interface{}, affinity map[string]interface{}, path ...string) (*computeBeta.Operation, error) {
+	obj, err := toRawJSON(resource)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := rawJSONObject(obj, path...)
+	if err != nil {
+		return nil, err
+	}
+	parent["reservationAffinity"] = affinity
+
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &computeBeta.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// readReservationAffinity reads the reservation_affinity block at path in
+// the resource at url.
+func readReservationAffinity(config *Config, url string, path ...string) ([]map[string]interface{}, error) {
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading reservation affinity: %s", err)
+	}
+
+	obj, err := rawJSONObject(res, path...)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading reservation affinity: %s", err)
+	}
+	return flattenReservationAffinity(obj["reservationAffinity"]), nil
+}
+
+// expandNodeConfigReservationAffinity returns the reservationAffinity of the
+// node_config block v, or nil if it has none.
+func expandNodeConfigReservationAffinity(v interface{}) map[string]interface{} {
+	nodeConfigs, ok := v.([]interface{})
+	if !ok || len(nodeConfigs) == 0 || nodeConfigs[0] == nil {
+		return nil
+	}
+	return expandReservationAffinity(nodeConfigs[0].(map[string]interface{})["reservation_affinity"])
+}
+
+// flattenNodeConfigReservationAffinity sets the reservation_affinity of the
+// flattened node_config block from the raw JSON node config.
+func flattenNodeConfigReservationAffinity(nodeConfig []map[string]interface{}, raw interface{}) {
+	obj, ok := raw.(map[string]interface{})
+	if !ok || len(nodeConfig) == 0 {
+		return
+	}
+	nodeConfig[0]["reservation_affinity"] = flattenReservationAffinity(obj["reservationAffinity"])
+}
+
+// containerClusterHasReservationAffinity reports whether the default node
+// config or one of the node pools of the cluster has a reservation affinity.
+func containerClusterHasReservationAffinity(d *schema.ResourceData) bool {
+	if expandNodeConfigReservationAffinity(d.Get("node_config")) != nil {
+		return true
+	}
+	for i := 0; i < d.Get("node_pool.#").(int); i++ {
+		if expandNodeConfigReservationAffinity(d.Get(fmt.Sprintf("node_pool.%d.node_config", i))) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// createContainerClusterWithReservationAffinities creates the cluster of req
+// in parent, with the reservation affinities of its node configs.
+func createContainerClusterWithReservationAffinities(d *schema.ResourceData, config *Config, parent string, req *containerBeta.CreateClusterRequest) (*containerBeta.Operation, error) {
+	obj, err := toRawJSON(req)
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := rawJSONObject(obj, "cluster")
+	if err != nil {
+		return nil, err
+	}
+
+	if affinity := expandNodeConfigReservationAffinity(d.Get("node_config")); affinity != nil {
+		nodeConfig, err := rawJSONObject(cluster, "nodeConfig")
+		if err != nil {
+			return nil, err
+		}
+		nodeConfig["reservationAffinity"] = affinity
+	}
+	nodePools, _ := cluster["nodePools"].([]interface{})
+	for i, raw := range nodePools {
+		if affinity := expandNodeConfigReservationAffinity(d.Get(fmt.Sprintf("node_pool.%d.node_config", i))); affinity != nil {
+			nodeConfig, err := rawJSONObject(raw.(map[string]interface{}), "config")
+			if err != nil {
+				return nil, err
+			}
+			nodeConfig["reservationAffinity"] = affinity
+		}
+	}
+
+	return sendContainerCreateRequest(config, "https://container.googleapis.com/v1beta1/"+parent+"/clusters", obj)
+}
+
+// createContainerNodePoolWithReservationAffinity creates the node pool of req
+// in parent, with the reservation affinity of its node config.
+func createContainerNodePoolWithReservationAffinity(config *Config, parent string, req *containerBeta.CreateNodePoolRequest, affinity map[string]interface{}) (*containerBeta.Operation, error) {
+	obj, err := toRawJSON(req)
+	if err != nil {
+		return nil, err
+	}
+	nodeConfig, err := rawJSONObject(obj, "nodePool", "config")
+	if err != nil {
+		return nil, err
+	}
+	nodeConfig["reservationAffinity"] = affinity
+
+	return sendContainerCreateRequest(config, "https://container.googleapis.com/v1beta1/"+parent+"/nodePools", obj)
+}
+
+func sendContainerCreateRequest(config *Config, url string, obj map[string]interface{}) (*containerBeta.Operation, error) {
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &containerBeta.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
@@ -0,0 +1,97 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandReservationAffinity(t *testing.T) {
+	cases := map[string]struct {
+		Affinity interface{}
+		Expected map[string]interface{}
+	}{
+		"no affinity": {
+			Affinity: []interface{}{},
+			Expected: nil,
+		},
+		"any reservation": {
+			Affinity: []interface{}{
+				map[string]interface{}{
+					"type":                 "ANY_RESERVATION",
+					"specific_reservation": []interface{}{},
+				},
+			},
+			Expected: map[string]interface{}{
+				"consumeReservationType": "ANY_RESERVATION",
+			},
+		},
+		"specific reservation": {
+			Affinity: []interface{}{
+				map[string]interface{}{
+					"type": "SPECIFIC_RESERVATION",
+					"specific_reservation": []interface{}{
+						map[string]interface{}{
+							"key":    "compute.googleapis.com/reservation-name",
+							"values": []interface{}{"my-reservation"},
+						},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"consumeReservationType": "SPECIFIC_RESERVATION",
+				"key":                    "compute.googleapis.com/reservation-name",
+				"values":                 []interface{}{"my-reservation"},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := expandReservationAffinity(tc.Affinity); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestFlattenReservationAffinity(t *testing.T) {
+	cases := map[string]struct {
+		Affinity interface{}
+		Expected []map[string]interface{}
+	}{
+		"no affinity": {
+			Affinity: nil,
+			Expected: nil,
+		},
+		"no reservation": {
+			Affinity: map[string]interface{}{
+				"consumeReservationType": "NO_RESERVATION",
+			},
+			Expected: []map[string]interface{}{
+				{"type": "NO_RESERVATION"},
+			},
+		},
+		"specific reservation": {
+			Affinity: map[string]interface{}{
+				"consumeReservationType": "SPECIFIC_RESERVATION",
+				"key":                    "compute.googleapis.com/reservation-name",
+				"values":                 []interface{}{"my-reservation"},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"type": "SPECIFIC_RESERVATION",
+					"specific_reservation": []map[string]interface{}{
+						{
+							"key":    "compute.googleapis.com/reservation-name",
+							"values": []interface{}{"my-reservation"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := flattenReservationAffinity(tc.Affinity); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
@@ -0,0 +1,665 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAccessContextManagerAccessLevel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccessContextManagerAccessLevelCreate,
+		Read:   resourceAccessContextManagerAccessLevelRead,
+		Update: resourceAccessContextManagerAccessLevelUpdate,
+		Delete: resourceAccessContextManagerAccessLevelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAccessContextManagerAccessLevelImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(360 * time.Second),
+			Update: schema.DefaultTimeout(360 * time.Second),
+			Delete: schema.DefaultTimeout(360 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"basic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_subnetworks": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"required_access_levels": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"members": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"negate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"device_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"require_screen_lock": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"allowed_encryption_statuses": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice([]string{"ENCRYPTION_UNSPECIFIED", "ENCRYPTION_UNSUPPORTED", "UNENCRYPTED", "ENCRYPTED"}, false),
+													},
+												},
+												"allowed_device_management_levels": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice([]string{"MANAGEMENT_UNSPECIFIED", "NONE", "BASIC", "COMPLETE"}, false),
+													},
+												},
+												"os_constraints": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"os_type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice([]string{"OS_UNSPECIFIED", "DESKTOP_MAC", "DESKTOP_WINDOWS", "DESKTOP_LINUX", "DESKTOP_CHROME_OS"}, false),
+															},
+															"minimum_version": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"combining_function": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"AND", "OR", ""}, false),
+							Default:      "AND",
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccessContextManagerAccessLevelCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandAccessContextManagerAccessLevelName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	titleProp, err := expandAccessContextManagerAccessLevelTitle(d.Get("title"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("title"); !isEmptyValue(reflect.ValueOf(titleProp)) && (ok || !reflect.DeepEqual(v, titleProp)) {
+		obj["title"] = titleProp
+	}
+	descriptionProp, err := expandAccessContextManagerAccessLevelDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	basicProp, err := expandAccessContextManagerAccessLevelBasic(d.Get("basic"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("basic"); !isEmptyValue(reflect.ValueOf(basicProp)) && (ok || !reflect.DeepEqual(v, basicProp)) {
+		obj["basic"] = basicProp
+	}
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/{{parent}}/accessLevels")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new AccessLevel: %#v", obj)
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error creating AccessLevel: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := accessContextManagerOperationWaitTime(
+		config, res, "Creating AccessLevel",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create AccessLevel: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating AccessLevel %q: %#v", d.Id(), res)
+
+	return resourceAccessContextManagerAccessLevelRead(d, meta)
+}
+
+func resourceAccessContextManagerAccessLevelRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("AccessContextManagerAccessLevel %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenAccessContextManagerAccessLevelName(res["name"])); err != nil {
+		return fmt.Errorf("Error reading AccessLevel: %s", err)
+	}
+	if err := d.Set("title", flattenAccessContextManagerAccessLevelTitle(res["title"])); err != nil {
+		return fmt.Errorf("Error reading AccessLevel: %s", err)
+	}
+	if err := d.Set("description", flattenAccessContextManagerAccessLevelDescription(res["description"])); err != nil {
+		return fmt.Errorf("Error reading AccessLevel: %s", err)
+	}
+	if err := d.Set("basic", flattenAccessContextManagerAccessLevelBasic(res["basic"])); err != nil {
+		return fmt.Errorf("Error reading AccessLevel: %s", err)
+	}
+	if err := d.Set("create_time", flattenAccessContextManagerAccessLevelCreateTime(res["createTime"])); err != nil {
+		return fmt.Errorf("Error reading AccessLevel: %s", err)
+	}
+	if err := d.Set("update_time", flattenAccessContextManagerAccessLevelUpdateTime(res["updateTime"])); err != nil {
+		return fmt.Errorf("Error reading AccessLevel: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAccessContextManagerAccessLevelUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	titleProp, err := expandAccessContextManagerAccessLevelTitle(d.Get("title"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("title"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, titleProp)) {
+		obj["title"] = titleProp
+	}
+	descriptionProp, err := expandAccessContextManagerAccessLevelDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	basicProp, err := expandAccessContextManagerAccessLevelBasic(d.Get("basic"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("basic"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, basicProp)) {
+		obj["basic"] = basicProp
+	}
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating AccessLevel %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+	if d.HasChange("title") {
+		updateMask = append(updateMask, "title")
+	}
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+	if d.HasChange("basic") {
+		updateMask = append(updateMask, "basic")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequest(config, "PATCH", url, obj)
+
+	if err != nil {
+		return fmt.Errorf("Error updating AccessLevel %q: %s", d.Id(), err)
+	}
+
+	err = accessContextManagerOperationWaitTime(
+		config, res, "Updating AccessLevel",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceAccessContextManagerAccessLevelRead(d, meta)
+}
+
+func resourceAccessContextManagerAccessLevelDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AccessLevel %q", d.Id())
+	res, err := sendRequest(config, "DELETE", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, "AccessLevel")
+	}
+
+	err = accessContextManagerOperationWaitTime(
+		config, res, "Deleting AccessLevel",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting AccessLevel %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceAccessContextManagerAccessLevelImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// current import_formats can't import fields with forward slashes in their value
+	parseImportId([]string{"(?P<name>.+)"}, d, config)
+
+	stringParts := strings.Split(d.Get("name").(string), "/")
+	if len(stringParts) != 4 || stringParts[0] != "accessPolicies" {
+		return nil, fmt.Errorf("Error parsing AccessLevel name, expected accessPolicies/{{policy}}/accessLevels/{{name}}, got %s", d.Get("name"))
+	}
+	d.Set("parent", fmt.Sprintf("%s/%s", stringParts[0], stringParts[1]))
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenAccessContextManagerAccessLevelName(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelTitle(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelDescription(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasic(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+	transformed["combining_function"] =
+		flattenAccessContextManagerAccessLevelBasicCombiningFunction(original["combiningFunction"])
+	transformed["conditions"] =
+		flattenAccessContextManagerAccessLevelBasicConditions(original["conditions"])
+	return []interface{}{transformed}
+}
+func flattenAccessContextManagerAccessLevelBasicCombiningFunction(v interface{}) interface{} {
+	if v == nil {
+		return "AND"
+	}
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasicConditions(v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed = append(transformed, map[string]interface{}{
+			"ip_subnetworks":         flattenAccessContextManagerAccessLevelBasicConditionsIpSubnetworks(original["ipSubnetworks"]),
+			"required_access_levels": flattenAccessContextManagerAccessLevelBasicConditionsRequiredAccessLevels(original["requiredAccessLevels"]),
+			"members":                flattenAccessContextManagerAccessLevelBasicConditionsMembers(original["members"]),
+			"negate":                 flattenAccessContextManagerAccessLevelBasicConditionsNegate(original["negate"]),
+			"device_policy":          flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicy(original["devicePolicy"]),
+		})
+	}
+	return transformed
+}
+func flattenAccessContextManagerAccessLevelBasicConditionsIpSubnetworks(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasicConditionsRequiredAccessLevels(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasicConditionsMembers(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasicConditionsNegate(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicy(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+	transformed["require_screen_lock"] =
+		flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyRequireScreenLock(original["requireScreenlock"])
+	transformed["allowed_encryption_statuses"] =
+		flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyAllowedEncryptionStatuses(original["allowedEncryptionStatuses"])
+	transformed["allowed_device_management_levels"] =
+		flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyAllowedDeviceManagementLevels(original["allowedDeviceManagementLevels"])
+	transformed["os_constraints"] =
+		flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraints(original["osConstraints"])
+	return []interface{}{transformed}
+}
+func flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyRequireScreenLock(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyAllowedEncryptionStatuses(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyAllowedDeviceManagementLevels(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraints(v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed = append(transformed, map[string]interface{}{
+			"os_type":         flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraintsOsType(original["osType"]),
+			"minimum_version": flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraintsMinimumVersion(original["minimumVersion"]),
+		})
+	}
+	return transformed
+}
+func flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraintsOsType(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraintsMinimumVersion(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelCreateTime(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessLevelUpdateTime(v interface{}) interface{} {
+	return v
+}
+
+func expandAccessContextManagerAccessLevelName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelTitle(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasic(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedCombiningFunction, err := expandAccessContextManagerAccessLevelBasicCombiningFunction(original["combining_function"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["combiningFunction"] = transformedCombiningFunction
+	transformedConditions, err := expandAccessContextManagerAccessLevelBasicConditions(original["conditions"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["conditions"] = transformedConditions
+	return transformed, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicCombiningFunction(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditions(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedIpSubnetworks, err := expandAccessContextManagerAccessLevelBasicConditionsIpSubnetworks(original["ip_subnetworks"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["ipSubnetworks"] = transformedIpSubnetworks
+		transformedRequiredAccessLevels, err := expandAccessContextManagerAccessLevelBasicConditionsRequiredAccessLevels(original["required_access_levels"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["requiredAccessLevels"] = transformedRequiredAccessLevels
+		transformedMembers, err := expandAccessContextManagerAccessLevelBasicConditionsMembers(original["members"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["members"] = transformedMembers
+		transformedNegate, err := expandAccessContextManagerAccessLevelBasicConditionsNegate(original["negate"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["negate"] = transformedNegate
+		transformedDevicePolicy, err := expandAccessContextManagerAccessLevelBasicConditionsDevicePolicy(original["device_policy"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["devicePolicy"] = transformedDevicePolicy
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsIpSubnetworks(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsRequiredAccessLevels(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsMembers(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsNegate(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsDevicePolicy(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRequireScreenLock, err := expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyRequireScreenLock(original["require_screen_lock"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["requireScreenlock"] = transformedRequireScreenLock
+	transformedAllowedEncryptionStatuses, err := expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyAllowedEncryptionStatuses(original["allowed_encryption_statuses"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["allowedEncryptionStatuses"] = transformedAllowedEncryptionStatuses
+	transformedAllowedDeviceManagementLevels, err := expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyAllowedDeviceManagementLevels(original["allowed_device_management_levels"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["allowedDeviceManagementLevels"] = transformedAllowedDeviceManagementLevels
+	transformedOsConstraints, err := expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraints(original["os_constraints"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["osConstraints"] = transformedOsConstraints
+	return transformed, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyRequireScreenLock(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyAllowedEncryptionStatuses(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyAllowedDeviceManagementLevels(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraints(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedOsType, err := expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraintsOsType(original["os_type"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["osType"] = transformedOsType
+		transformedMinimumVersion, err := expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraintsMinimumVersion(original["minimum_version"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["minimumVersion"] = transformedMinimumVersion
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraintsOsType(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessLevelBasicConditionsDevicePolicyOsConstraintsMinimumVersion(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,115 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Since each test here is acting on the same organization and only one AccessPolicy
+// can exist, they need to be ran serially. See AccessPolicy for the test runner.
+
+func testAccAccessContextManagerAccessLevel_basicTest(t *testing.T) {
+	org := getTestOrgFromEnv(t)
+	levelName := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAccessContextManagerAccessLevelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessContextManagerAccessLevel_basic(org, "my policy", levelName),
+			},
+			{
+				ResourceName:      "google_access_context_manager_access_level.test-access",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccessContextManagerAccessLevel_full(org, "my policy", levelName),
+			},
+			{
+				ResourceName:      "google_access_context_manager_access_level.test-access",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessContextManagerAccessLevelDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_access_context_manager_access_level" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := "https://accesscontextmanager.googleapis.com/v1beta/" + rs.Primary.ID
+		_, err := sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("AccessLevel still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func testAccAccessContextManagerAccessLevel_basic(org, policyTitle, levelName string) string {
+	return fmt.Sprintf(`
+resource "google_access_context_manager_access_policy" "test-access" {
+  parent = "organizations/%s"
+  title  = "%s"
+}
+
+resource "google_access_context_manager_access_level" "test-access" {
+  parent      = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}"
+  name        = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}/accessLevels/%s"
+  title       = "%s"
+  description = "hello"
+  basic {
+    combining_function = "AND"
+    conditions {
+      ip_subnetworks = ["192.0.4.0/24"]
+    }
+  }
+}
+`, org, policyTitle, levelName, levelName)
+}
+
+func testAccAccessContextManagerAccessLevel_full(org, policyTitle, levelName string) string {
+	return fmt.Sprintf(`
+resource "google_access_context_manager_access_policy" "test-access" {
+  parent = "organizations/%s"
+  title  = "%s"
+}
+
+resource "google_access_context_manager_access_level" "test-access" {
+  parent      = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}"
+  name        = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}/accessLevels/%s"
+  title       = "%s"
+  description = "hello again"
+  basic {
+    combining_function = "OR"
+    conditions {
+      ip_subnetworks = ["192.0.4.0/24"]
+      members        = ["user:test@google.com", "user:test2@google.com"]
+      negate         = false
+      device_policy {
+        require_screen_lock              = false
+        allowed_encryption_statuses      = ["ENCRYPTED", "UNENCRYPTED"]
+        allowed_device_management_levels = ["COMPLETE"]
+        os_constraints {
+          os_type         = "DESKTOP_CHROME_OS"
+          minimum_version = "10.0.0"
+        }
+      }
+    }
+  }
+}
+`, org, policyTitle, levelName, levelName)
+}
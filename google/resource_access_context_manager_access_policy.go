@@ -0,0 +1,270 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAccessContextManagerAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccessContextManagerAccessPolicyCreate,
+		Read:   resourceAccessContextManagerAccessPolicyRead,
+		Update: resourceAccessContextManagerAccessPolicyUpdate,
+		Delete: resourceAccessContextManagerAccessPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAccessContextManagerAccessPolicyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(360 * time.Second),
+			Update: schema.DefaultTimeout(360 * time.Second),
+			Delete: schema.DefaultTimeout(360 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccessContextManagerAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	parentProp, err := expandAccessContextManagerAccessPolicyParent(d.Get("parent"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("parent"); !isEmptyValue(reflect.ValueOf(parentProp)) && (ok || !reflect.DeepEqual(v, parentProp)) {
+		obj["parent"] = parentProp
+	}
+	titleProp, err := expandAccessContextManagerAccessPolicyTitle(d.Get("title"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("title"); !isEmptyValue(reflect.ValueOf(titleProp)) && (ok || !reflect.DeepEqual(v, titleProp)) {
+		obj["title"] = titleProp
+	}
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/accessPolicies")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new AccessPolicy: %#v", obj)
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error creating AccessPolicy: %s", err)
+	}
+
+	var opRes map[string]interface{}
+	waitErr := accessContextManagerOperationWaitTimeWithResponse(
+		config, res, &opRes, "Creating AccessPolicy",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		return fmt.Errorf("Error waiting to create AccessPolicy: %s", waitErr)
+	}
+
+	// The name of the policy is generated by the server, it's only known
+	// once the operation completes.
+	if err := d.Set("name", flattenAccessContextManagerAccessPolicyName(opRes["name"])); err != nil {
+		return err
+	}
+
+	// Store the ID now that the name is known
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating AccessPolicy %q: %#v", d.Id(), res)
+
+	return resourceAccessContextManagerAccessPolicyRead(d, meta)
+}
+
+func resourceAccessContextManagerAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/accessPolicies/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("AccessContextManagerAccessPolicy %q", d.Id()))
+	}
+
+	if err := d.Set("parent", flattenAccessContextManagerAccessPolicyParent(res["parent"])); err != nil {
+		return fmt.Errorf("Error reading AccessPolicy: %s", err)
+	}
+	if err := d.Set("title", flattenAccessContextManagerAccessPolicyTitle(res["title"])); err != nil {
+		return fmt.Errorf("Error reading AccessPolicy: %s", err)
+	}
+	if err := d.Set("create_time", flattenAccessContextManagerAccessPolicyCreateTime(res["createTime"])); err != nil {
+		return fmt.Errorf("Error reading AccessPolicy: %s", err)
+	}
+	if err := d.Set("update_time", flattenAccessContextManagerAccessPolicyUpdateTime(res["updateTime"])); err != nil {
+		return fmt.Errorf("Error reading AccessPolicy: %s", err)
+	}
+	if err := d.Set("name", flattenAccessContextManagerAccessPolicyName(res["name"])); err != nil {
+		return fmt.Errorf("Error reading AccessPolicy: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAccessContextManagerAccessPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	titleProp, err := expandAccessContextManagerAccessPolicyTitle(d.Get("title"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("title"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, titleProp)) {
+		obj["title"] = titleProp
+	}
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/accessPolicies/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating AccessPolicy %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+	if d.HasChange("title") {
+		updateMask = append(updateMask, "title")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequest(config, "PATCH", url, obj)
+
+	if err != nil {
+		return fmt.Errorf("Error updating AccessPolicy %q: %s", d.Id(), err)
+	}
+
+	err = accessContextManagerOperationWaitTime(
+		config, res, "Updating AccessPolicy",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceAccessContextManagerAccessPolicyRead(d, meta)
+}
+
+func resourceAccessContextManagerAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/accessPolicies/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AccessPolicy %q", d.Id())
+	res, err := sendRequest(config, "DELETE", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, "AccessPolicy")
+	}
+
+	err = accessContextManagerOperationWaitTime(
+		config, res, "Deleting AccessPolicy",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting AccessPolicy %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceAccessContextManagerAccessPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	parseImportId([]string{"accessPolicies/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config)
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenAccessContextManagerAccessPolicyParent(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessPolicyTitle(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessPolicyCreateTime(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessPolicyUpdateTime(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerAccessPolicyName(v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	return NameFromSelfLinkStateFunc(v)
+}
+
+func expandAccessContextManagerAccessPolicyParent(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerAccessPolicyTitle(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,457 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAccessContextManagerServicePerimeter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccessContextManagerServicePerimeterCreate,
+		Read:   resourceAccessContextManagerServicePerimeterRead,
+		Update: resourceAccessContextManagerServicePerimeterUpdate,
+		Delete: resourceAccessContextManagerServicePerimeterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAccessContextManagerServicePerimeterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(360 * time.Second),
+			Update: schema.DefaultTimeout(360 * time.Second),
+			Delete: schema.DefaultTimeout(360 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"perimeter_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PERIMETER_TYPE_REGULAR", "PERIMETER_TYPE_BRIDGE", ""}, false),
+				Default:      "PERIMETER_TYPE_REGULAR",
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resources": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"access_levels": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"restricted_services": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"unrestricted_services": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccessContextManagerServicePerimeterCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandAccessContextManagerServicePerimeterName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	titleProp, err := expandAccessContextManagerServicePerimeterTitle(d.Get("title"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("title"); !isEmptyValue(reflect.ValueOf(titleProp)) && (ok || !reflect.DeepEqual(v, titleProp)) {
+		obj["title"] = titleProp
+	}
+	descriptionProp, err := expandAccessContextManagerServicePerimeterDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	perimeterTypeProp, err := expandAccessContextManagerServicePerimeterPerimeterType(d.Get("perimeter_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("perimeter_type"); !isEmptyValue(reflect.ValueOf(perimeterTypeProp)) && (ok || !reflect.DeepEqual(v, perimeterTypeProp)) {
+		obj["perimeterType"] = perimeterTypeProp
+	}
+	statusProp, err := expandAccessContextManagerServicePerimeterStatus(d.Get("status"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("status"); !isEmptyValue(reflect.ValueOf(statusProp)) && (ok || !reflect.DeepEqual(v, statusProp)) {
+		obj["status"] = statusProp
+	}
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/{{parent}}/servicePerimeters")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ServicePerimeter: %#v", obj)
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error creating ServicePerimeter: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	waitErr := accessContextManagerOperationWaitTime(
+		config, res, "Creating ServicePerimeter",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create ServicePerimeter: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating ServicePerimeter %q: %#v", d.Id(), res)
+
+	return resourceAccessContextManagerServicePerimeterRead(d, meta)
+}
+
+func resourceAccessContextManagerServicePerimeterRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("AccessContextManagerServicePerimeter %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenAccessContextManagerServicePerimeterName(res["name"])); err != nil {
+		return fmt.Errorf("Error reading ServicePerimeter: %s", err)
+	}
+	if err := d.Set("title", flattenAccessContextManagerServicePerimeterTitle(res["title"])); err != nil {
+		return fmt.Errorf("Error reading ServicePerimeter: %s", err)
+	}
+	if err := d.Set("description", flattenAccessContextManagerServicePerimeterDescription(res["description"])); err != nil {
+		return fmt.Errorf("Error reading ServicePerimeter: %s", err)
+	}
+	if err := d.Set("perimeter_type", flattenAccessContextManagerServicePerimeterPerimeterType(res["perimeterType"])); err != nil {
+		return fmt.Errorf("Error reading ServicePerimeter: %s", err)
+	}
+	if err := d.Set("status", flattenAccessContextManagerServicePerimeterStatus(res["status"])); err != nil {
+		return fmt.Errorf("Error reading ServicePerimeter: %s", err)
+	}
+	if err := d.Set("create_time", flattenAccessContextManagerServicePerimeterCreateTime(res["createTime"])); err != nil {
+		return fmt.Errorf("Error reading ServicePerimeter: %s", err)
+	}
+	if err := d.Set("update_time", flattenAccessContextManagerServicePerimeterUpdateTime(res["updateTime"])); err != nil {
+		return fmt.Errorf("Error reading ServicePerimeter: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAccessContextManagerServicePerimeterUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	titleProp, err := expandAccessContextManagerServicePerimeterTitle(d.Get("title"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("title"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, titleProp)) {
+		obj["title"] = titleProp
+	}
+	descriptionProp, err := expandAccessContextManagerServicePerimeterDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	statusProp, err := expandAccessContextManagerServicePerimeterStatus(d.Get("status"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("status"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, statusProp)) {
+		obj["status"] = statusProp
+	}
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating ServicePerimeter %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+	if d.HasChange("title") {
+		updateMask = append(updateMask, "title")
+	}
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+	if d.HasChange("status") {
+		updateMask = append(updateMask, "status")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequest(config, "PATCH", url, obj)
+
+	if err != nil {
+		return fmt.Errorf("Error updating ServicePerimeter %q: %s", d.Id(), err)
+	}
+
+	err = accessContextManagerOperationWaitTime(
+		config, res, "Updating ServicePerimeter",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceAccessContextManagerServicePerimeterRead(d, meta)
+}
+
+func resourceAccessContextManagerServicePerimeterDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://accesscontextmanager.googleapis.com/v1beta/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting ServicePerimeter %q", d.Id())
+	res, err := sendRequest(config, "DELETE", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, "ServicePerimeter")
+	}
+
+	err = accessContextManagerOperationWaitTime(
+		config, res, "Deleting ServicePerimeter",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting ServicePerimeter %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceAccessContextManagerServicePerimeterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// current import_formats can't import fields with forward slashes in their value
+	parseImportId([]string{"(?P<name>.+)"}, d, config)
+
+	stringParts := strings.Split(d.Get("name").(string), "/")
+	if len(stringParts) != 4 || stringParts[0] != "accessPolicies" {
+		return nil, fmt.Errorf("Error parsing ServicePerimeter name, expected accessPolicies/{{policy}}/servicePerimeters/{{name}}, got %s", d.Get("name"))
+	}
+	d.Set("parent", fmt.Sprintf("%s/%s", stringParts[0], stringParts[1]))
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenAccessContextManagerServicePerimeterName(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerServicePerimeterTitle(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerServicePerimeterDescription(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerServicePerimeterPerimeterType(v interface{}) interface{} {
+	if v == nil {
+		return "PERIMETER_TYPE_REGULAR"
+	}
+	return v
+}
+
+func flattenAccessContextManagerServicePerimeterStatus(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+	transformed["resources"] =
+		flattenAccessContextManagerServicePerimeterStatusResources(original["resources"])
+	transformed["access_levels"] =
+		flattenAccessContextManagerServicePerimeterStatusAccessLevels(original["accessLevels"])
+	transformed["restricted_services"] =
+		flattenAccessContextManagerServicePerimeterStatusRestrictedServices(original["restrictedServices"])
+	transformed["unrestricted_services"] =
+		flattenAccessContextManagerServicePerimeterStatusUnrestrictedServices(original["unrestrictedServices"])
+	return []interface{}{transformed}
+}
+func flattenAccessContextManagerServicePerimeterStatusResources(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerServicePerimeterStatusAccessLevels(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerServicePerimeterStatusRestrictedServices(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerServicePerimeterStatusUnrestrictedServices(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerServicePerimeterCreateTime(v interface{}) interface{} {
+	return v
+}
+
+func flattenAccessContextManagerServicePerimeterUpdateTime(v interface{}) interface{} {
+	return v
+}
+
+func expandAccessContextManagerServicePerimeterName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerServicePerimeterTitle(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerServicePerimeterDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerServicePerimeterPerimeterType(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerServicePerimeterStatus(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedResources, err := expandAccessContextManagerServicePerimeterStatusResources(original["resources"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["resources"] = transformedResources
+	transformedAccessLevels, err := expandAccessContextManagerServicePerimeterStatusAccessLevels(original["access_levels"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["accessLevels"] = transformedAccessLevels
+	transformedRestrictedServices, err := expandAccessContextManagerServicePerimeterStatusRestrictedServices(original["restricted_services"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["restrictedServices"] = transformedRestrictedServices
+	transformedUnrestrictedServices, err := expandAccessContextManagerServicePerimeterStatusUnrestrictedServices(original["unrestricted_services"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["unrestrictedServices"] = transformedUnrestrictedServices
+	return transformed, nil
+}
+
+func expandAccessContextManagerServicePerimeterStatusResources(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerServicePerimeterStatusAccessLevels(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerServicePerimeterStatusRestrictedServices(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAccessContextManagerServicePerimeterStatusUnrestrictedServices(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,141 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Since each test here is acting on the same organization and only one AccessPolicy
+// can exist, they need to be ran serially. See AccessPolicy for the test runner.
+
+func testAccAccessContextManagerServicePerimeter_basicTest(t *testing.T) {
+	org := getTestOrgFromEnv(t)
+	project := getTestProjectFromEnv()
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAccessContextManagerServicePerimeterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessContextManagerServicePerimeter_basic(org, "my policy", suffix),
+			},
+			{
+				ResourceName:      "google_access_context_manager_service_perimeter.test-access",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccessContextManagerServicePerimeter_update(org, "my policy", suffix, project),
+			},
+			{
+				ResourceName:      "google_access_context_manager_service_perimeter.test-access",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_access_context_manager_service_perimeter.test-bridge",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessContextManagerServicePerimeterDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_access_context_manager_service_perimeter" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := "https://accesscontextmanager.googleapis.com/v1beta/" + rs.Primary.ID
+		_, err := sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ServicePerimeter still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func testAccAccessContextManagerServicePerimeter_basic(org, policyTitle, suffix string) string {
+	return fmt.Sprintf(`
+resource "google_access_context_manager_access_policy" "test-access" {
+  parent = "organizations/%s"
+  title  = "%s"
+}
+
+resource "google_access_context_manager_access_level" "test-access" {
+  parent = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}"
+  name   = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}/accessLevels/tf_test_level_%s"
+  title  = "tf_test_level_%s"
+  basic {
+    conditions {
+      ip_subnetworks = ["192.0.4.0/24"]
+    }
+  }
+}
+
+resource "google_access_context_manager_service_perimeter" "test-access" {
+  parent = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}"
+  name   = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}/servicePerimeters/tf_test_perimeter_%s"
+  title  = "tf_test_perimeter_%s"
+  status {
+    restricted_services = ["storage.googleapis.com"]
+  }
+}
+`, org, policyTitle, suffix, suffix, suffix, suffix)
+}
+
+func testAccAccessContextManagerServicePerimeter_update(org, policyTitle, suffix, project string) string {
+	return fmt.Sprintf(`
+resource "google_access_context_manager_access_policy" "test-access" {
+  parent = "organizations/%s"
+  title  = "%s"
+}
+
+resource "google_access_context_manager_access_level" "test-access" {
+  parent = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}"
+  name   = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}/accessLevels/tf_test_level_%s"
+  title  = "tf_test_level_%s"
+  basic {
+    conditions {
+      ip_subnetworks = ["192.0.4.0/24"]
+    }
+  }
+}
+
+data "google_project" "project" {
+  project_id = "%s"
+}
+
+resource "google_access_context_manager_service_perimeter" "test-access" {
+  parent      = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}"
+  name        = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}/servicePerimeters/tf_test_perimeter_%s"
+  title       = "tf_test_perimeter_%s"
+  description = "updated"
+  status {
+    resources           = ["projects/${data.google_project.project.number}"]
+    access_levels       = ["${google_access_context_manager_access_level.test-access.name}"]
+    restricted_services = ["bigquery.googleapis.com", "storage.googleapis.com"]
+  }
+}
+
+resource "google_access_context_manager_service_perimeter" "test-bridge" {
+  parent         = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}"
+  name           = "accessPolicies/${google_access_context_manager_access_policy.test-access.name}/servicePerimeters/tf_test_bridge_%s"
+  title          = "tf_test_bridge_%s"
+  perimeter_type = "PERIMETER_TYPE_BRIDGE"
+  status {
+    resources = ["projects/${data.google_project.project.number}"]
+  }
+}
+`, org, policyTitle, suffix, suffix, project, suffix, suffix, suffix, suffix)
+}
@@ -0,0 +1,677 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceBillingBudget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBillingBudgetCreate,
+		Read:   resourceBillingBudgetRead,
+		Update: resourceBillingBudgetUpdate,
+		Delete: resourceBillingBudgetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBillingBudgetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"billing_account": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"amount": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"specified_amount": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"currency_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Optional: true,
+									},
+									"units": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"nanos": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"last_period_amount": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"threshold_rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"threshold_percent": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"spend_basis": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"CURRENT_SPEND", "FORECASTED_SPEND", ""}, false),
+							Default:      "CURRENT_SPEND",
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"budget_filter": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"projects": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"credit_types_treatment": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"INCLUDE_ALL_CREDITS", "EXCLUDE_ALL_CREDITS", ""}, false),
+							Default:      "INCLUDE_ALL_CREDITS",
+						},
+						"services": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"all_updates_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pubsub_topic": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"schema_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "1.0",
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBillingBudgetCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	amountProp, err := expandBillingBudgetAmount(d.Get("amount"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("amount"); !isEmptyValue(reflect.ValueOf(amountProp)) && (ok || !reflect.DeepEqual(v, amountProp)) {
+		obj["amount"] = amountProp
+	}
+	thresholdRulesProp, err := expandBillingBudgetThresholdRules(d.Get("threshold_rules"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("threshold_rules"); !isEmptyValue(reflect.ValueOf(thresholdRulesProp)) && (ok || !reflect.DeepEqual(v, thresholdRulesProp)) {
+		obj["thresholdRules"] = thresholdRulesProp
+	}
+	displayNameProp, err := expandBillingBudgetDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	budgetFilterProp, err := expandBillingBudgetBudgetFilter(d.Get("budget_filter"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("budget_filter"); !isEmptyValue(reflect.ValueOf(budgetFilterProp)) && (ok || !reflect.DeepEqual(v, budgetFilterProp)) {
+		obj["budgetFilter"] = budgetFilterProp
+	}
+	allUpdatesRuleProp, err := expandBillingBudgetAllUpdatesRule(d.Get("all_updates_rule"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("all_updates_rule"); !isEmptyValue(reflect.ValueOf(allUpdatesRuleProp)) && (ok || !reflect.DeepEqual(v, allUpdatesRuleProp)) {
+		obj["allUpdatesRule"] = allUpdatesRuleProp
+	}
+
+	obj, err = resourceBillingBudgetEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://billingbudgets.googleapis.com/v1beta1/billingAccounts/{{billing_account}}/budgets")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Budget: %#v", obj)
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error creating Budget: %s", err)
+	}
+
+	// The name of the budget is generated by the server and returned in the
+	// response, it's needed to read the budget back.
+	if err := d.Set("name", flattenBillingBudgetName(res["name"])); err != nil {
+		return err
+	}
+
+	// Store the ID now that the name is known
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Budget %q: %#v", d.Id(), res)
+
+	return resourceBillingBudgetRead(d, meta)
+}
+
+func resourceBillingBudgetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://billingbudgets.googleapis.com/v1beta1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BillingBudget %q", d.Id()))
+	}
+
+	if err := d.Set("amount", flattenBillingBudgetAmount(res["amount"])); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("threshold_rules", flattenBillingBudgetThresholdRules(res["thresholdRules"])); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("display_name", flattenBillingBudgetDisplayName(res["displayName"])); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("budget_filter", flattenBillingBudgetBudgetFilter(res["budgetFilter"])); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("all_updates_rule", flattenBillingBudgetAllUpdatesRule(res["allUpdatesRule"])); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+	if err := d.Set("name", flattenBillingBudgetName(res["name"])); err != nil {
+		return fmt.Errorf("Error reading Budget: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBillingBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	amountProp, err := expandBillingBudgetAmount(d.Get("amount"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("amount"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, amountProp)) {
+		obj["amount"] = amountProp
+	}
+	thresholdRulesProp, err := expandBillingBudgetThresholdRules(d.Get("threshold_rules"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("threshold_rules"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, thresholdRulesProp)) {
+		obj["thresholdRules"] = thresholdRulesProp
+	}
+	displayNameProp, err := expandBillingBudgetDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	budgetFilterProp, err := expandBillingBudgetBudgetFilter(d.Get("budget_filter"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("budget_filter"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, budgetFilterProp)) {
+		obj["budgetFilter"] = budgetFilterProp
+	}
+	allUpdatesRuleProp, err := expandBillingBudgetAllUpdatesRule(d.Get("all_updates_rule"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("all_updates_rule"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, allUpdatesRuleProp)) {
+		obj["allUpdatesRule"] = allUpdatesRuleProp
+	}
+
+	obj, err = resourceBillingBudgetEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://billingbudgets.googleapis.com/v1beta1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Budget %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+	if d.HasChange("budget_filter") {
+		updateMask = append(updateMask, "budgetFilter")
+	}
+	if d.HasChange("amount") {
+		updateMask = append(updateMask, "amount")
+	}
+	if d.HasChange("threshold_rules") {
+		updateMask = append(updateMask, "thresholdRules")
+	}
+	if d.HasChange("all_updates_rule") {
+		updateMask = append(updateMask, "allUpdatesRule")
+	}
+	// updateMask is sent in the body of the request
+	obj["updateMask"] = strings.Join(updateMask, ",")
+	res, err := sendRequest(config, "PATCH", url, obj)
+
+	if err != nil {
+		return fmt.Errorf("Error updating Budget %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating Budget %q: %#v", d.Id(), res)
+
+	return resourceBillingBudgetRead(d, meta)
+}
+
+func resourceBillingBudgetDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://billingbudgets.googleapis.com/v1beta1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Budget %q", d.Id())
+	res, err := sendRequest(config, "DELETE", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, "Budget")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Budget %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceBillingBudgetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// current import_formats can't import fields with forward slashes in their value
+	parseImportId([]string{"(?P<name>.+)"}, d, config)
+
+	stringParts := strings.Split(d.Get("name").(string), "/")
+	if len(stringParts) != 4 || stringParts[0] != "billingAccounts" {
+		return nil, fmt.Errorf("Error parsing Budget name, expected billingAccounts/{{billing_account}}/budgets/{{budget}}, got %s", d.Get("name"))
+	}
+	d.Set("billing_account", stringParts[1])
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenBillingBudgetAmount(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+	transformed["specified_amount"] =
+		flattenBillingBudgetAmountSpecifiedAmount(original["specifiedAmount"])
+	transformed["last_period_amount"] =
+		flattenBillingBudgetAmountLastPeriodAmount(original["lastPeriodAmount"])
+	return []interface{}{transformed}
+}
+func flattenBillingBudgetAmountSpecifiedAmount(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+	transformed["currency_code"] =
+		flattenBillingBudgetAmountSpecifiedAmountCurrencyCode(original["currencyCode"])
+	transformed["units"] =
+		flattenBillingBudgetAmountSpecifiedAmountUnits(original["units"])
+	transformed["nanos"] =
+		flattenBillingBudgetAmountSpecifiedAmountNanos(original["nanos"])
+	return []interface{}{transformed}
+}
+func flattenBillingBudgetAmountSpecifiedAmountCurrencyCode(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetAmountSpecifiedAmountUnits(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetAmountSpecifiedAmountNanos(v interface{}) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenBillingBudgetAmountLastPeriodAmount(v interface{}) interface{} {
+	return v != nil
+}
+
+func flattenBillingBudgetThresholdRules(v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed = append(transformed, map[string]interface{}{
+			"threshold_percent": flattenBillingBudgetThresholdRulesThresholdPercent(original["thresholdPercent"]),
+			"spend_basis":       flattenBillingBudgetThresholdRulesSpendBasis(original["spendBasis"]),
+		})
+	}
+	return transformed
+}
+func flattenBillingBudgetThresholdRulesThresholdPercent(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetThresholdRulesSpendBasis(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetDisplayName(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetBudgetFilter(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+	transformed["projects"] =
+		flattenBillingBudgetBudgetFilterProjects(original["projects"])
+	transformed["credit_types_treatment"] =
+		flattenBillingBudgetBudgetFilterCreditTypesTreatment(original["creditTypesTreatment"])
+	transformed["services"] =
+		flattenBillingBudgetBudgetFilterServices(original["services"])
+	return []interface{}{transformed}
+}
+func flattenBillingBudgetBudgetFilterProjects(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetBudgetFilterCreditTypesTreatment(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetBudgetFilterServices(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetAllUpdatesRule(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+	transformed["pubsub_topic"] =
+		flattenBillingBudgetAllUpdatesRulePubsubTopic(original["pubsubTopic"])
+	transformed["schema_version"] =
+		flattenBillingBudgetAllUpdatesRuleSchemaVersion(original["schemaVersion"])
+	return []interface{}{transformed}
+}
+func flattenBillingBudgetAllUpdatesRulePubsubTopic(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetAllUpdatesRuleSchemaVersion(v interface{}) interface{} {
+	return v
+}
+
+func flattenBillingBudgetName(v interface{}) interface{} {
+	return v
+}
+
+func expandBillingBudgetAmount(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSpecifiedAmount, err := expandBillingBudgetAmountSpecifiedAmount(original["specified_amount"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["specifiedAmount"] = transformedSpecifiedAmount
+	transformedLastPeriodAmount, err := expandBillingBudgetAmountLastPeriodAmount(original["last_period_amount"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["lastPeriodAmount"] = transformedLastPeriodAmount
+	return transformed, nil
+}
+
+func expandBillingBudgetAmountSpecifiedAmount(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedCurrencyCode, err := expandBillingBudgetAmountSpecifiedAmountCurrencyCode(original["currency_code"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["currencyCode"] = transformedCurrencyCode
+	transformedUnits, err := expandBillingBudgetAmountSpecifiedAmountUnits(original["units"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["units"] = transformedUnits
+	transformedNanos, err := expandBillingBudgetAmountSpecifiedAmountNanos(original["nanos"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["nanos"] = transformedNanos
+	return transformed, nil
+}
+
+func expandBillingBudgetAmountSpecifiedAmountCurrencyCode(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetAmountSpecifiedAmountUnits(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetAmountSpecifiedAmountNanos(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetAmountLastPeriodAmount(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	if v == nil || !v.(bool) {
+		return nil, nil
+	}
+
+	return struct{}{}, nil
+}
+
+func expandBillingBudgetThresholdRules(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedThresholdPercent, err := expandBillingBudgetThresholdRulesThresholdPercent(original["threshold_percent"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["thresholdPercent"] = transformedThresholdPercent
+		transformedSpendBasis, err := expandBillingBudgetThresholdRulesSpendBasis(original["spend_basis"], d, config)
+		if err != nil {
+			return nil, err
+		}
+		transformed["spendBasis"] = transformedSpendBasis
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandBillingBudgetThresholdRulesThresholdPercent(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetThresholdRulesSpendBasis(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetDisplayName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetBudgetFilter(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedProjects, err := expandBillingBudgetBudgetFilterProjects(original["projects"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["projects"] = transformedProjects
+	transformedCreditTypesTreatment, err := expandBillingBudgetBudgetFilterCreditTypesTreatment(original["credit_types_treatment"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["creditTypesTreatment"] = transformedCreditTypesTreatment
+	transformedServices, err := expandBillingBudgetBudgetFilterServices(original["services"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["services"] = transformedServices
+	return transformed, nil
+}
+
+func expandBillingBudgetBudgetFilterProjects(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetBudgetFilterCreditTypesTreatment(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetBudgetFilterServices(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetAllUpdatesRule(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedPubsubTopic, err := expandBillingBudgetAllUpdatesRulePubsubTopic(original["pubsub_topic"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["pubsubTopic"] = transformedPubsubTopic
+	transformedSchemaVersion, err := expandBillingBudgetAllUpdatesRuleSchemaVersion(original["schema_version"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["schemaVersion"] = transformedSchemaVersion
+	return transformed, nil
+}
+
+func expandBillingBudgetAllUpdatesRulePubsubTopic(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBillingBudgetAllUpdatesRuleSchemaVersion(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func resourceBillingBudgetEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	// The v1beta1 API expects the budget to be wrapped in the request body.
+	return map[string]interface{}{"budget": obj}, nil
+}
@@ -0,0 +1,142 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBillingBudget_basic(t *testing.T) {
+	t.Parallel()
+
+	billing := getTestBillingAccountFromEnv(t)
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBillingBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingBudget_basic(billing, name),
+			},
+			{
+				ResourceName:      "google_billing_budget.budget",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBillingBudget_update(t *testing.T) {
+	t.Parallel()
+
+	billing := getTestBillingAccountFromEnv(t)
+	project := getTestProjectFromEnv()
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBillingBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingBudget_basic(billing, name),
+			},
+			{
+				ResourceName:      "google_billing_budget.budget",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBillingBudget_full(billing, name, project),
+			},
+			{
+				ResourceName:      "google_billing_budget.budget",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBillingBudgetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_billing_budget" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := "https://billingbudgets.googleapis.com/v1beta1/" + rs.Primary.ID
+		_, err := sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("Budget still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func testAccBillingBudget_basic(billingAccountId, name string) string {
+	return fmt.Sprintf(`
+resource "google_billing_budget" "budget" {
+  billing_account = "%s"
+  display_name    = "%s"
+
+  amount {
+    specified_amount {
+      currency_code = "USD"
+      units         = "100"
+    }
+  }
+
+  threshold_rules {
+    threshold_percent = 0.5
+  }
+}
+`, billingAccountId, name)
+}
+
+func testAccBillingBudget_full(billingAccountId, name, project string) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {
+  project_id = "%s"
+}
+
+resource "google_pubsub_topic" "budget" {
+  name = "%s"
+}
+
+resource "google_billing_budget" "budget" {
+  billing_account = "%s"
+  display_name    = "%s"
+
+  budget_filter {
+    projects               = ["projects/${data.google_project.project.number}"]
+    credit_types_treatment = "EXCLUDE_ALL_CREDITS"
+    services               = ["services/24E6-581D-38E5"] # Bigquery
+  }
+
+  amount {
+    last_period_amount = true
+  }
+
+  threshold_rules {
+    threshold_percent = 1.0
+  }
+  threshold_rules {
+    threshold_percent = 1.5
+    spend_basis       = "FORECASTED_SPEND"
+  }
+
+  all_updates_rule {
+    pubsub_topic = "${google_pubsub_topic.budget.id}"
+  }
+}
+`, project, name, billingAccountId, name)
+}
@@ -168,6 +168,7 @@ resource "google_project" "acceptance" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "acceptance" {
@@ -217,6 +218,7 @@ resource "google_project" "acceptance" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "acceptance" {
@@ -255,6 +257,7 @@ resource "google_project" "acceptance" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "acceptance" {
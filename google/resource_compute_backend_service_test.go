@@ -588,6 +588,36 @@ func TestAccComputeBackendService_withMaxConnectionsPerInstance(t *testing.T) {
 	}
 }
 
+func TestAccComputeBackendService_withNetworkEndpointGroupBackend(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeBackendServiceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeBackendService_withNetworkEndpointGroupBackend(suffix, 10),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_backend_service.lipsum",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeBackendService_withNetworkEndpointGroupBackend(suffix, 20),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_backend_service.lipsum",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccComputeBackendService_withCustomHeaders(t *testing.T) {
 	t.Parallel()
 
@@ -987,6 +1017,52 @@ resource "google_compute_health_check" "default" {
 `, serviceName, maxConnectionsPerInstance, igName, itName, checkName)
 }
 
+func testAccComputeBackendService_withNetworkEndpointGroupBackend(suffix string, maxRatePerEndpoint int64) string {
+	return fmt.Sprintf(`
+resource "google_compute_backend_service" "lipsum" {
+  name        = "tf-test-%s"
+  description = "Hello World 1234"
+  port_name   = "http"
+  protocol    = "HTTP"
+
+  backend {
+    group                 = "${google_compute_network_endpoint_group.neg.self_link}"
+    balancing_mode        = "RATE"
+    max_rate_per_endpoint = %v
+  }
+
+  health_checks = ["${google_compute_health_check.default.self_link}"]
+}
+
+resource "google_compute_network_endpoint_group" "neg" {
+  name         = "tf-test-%s"
+  network      = "${google_compute_network.default.self_link}"
+  subnetwork   = "${google_compute_subnetwork.default.self_link}"
+  default_port = "90"
+  zone         = "us-central1-a"
+}
+
+resource "google_compute_network" "default" {
+  name                    = "tf-test-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-%s"
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.self_link}"
+}
+
+resource "google_compute_health_check" "default" {
+  name = "tf-test-%s"
+  http_health_check {
+    port = "80"
+  }
+}
+`, suffix, maxRatePerEndpoint, suffix, suffix, suffix, suffix)
+}
+
 func testAccComputeBackendService_withCustomHeaders(serviceName, checkName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_backend_service" "foobar" {
@@ -280,6 +280,14 @@ func resourceComputeDisk() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"resource_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+			},
 			"size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -317,6 +325,12 @@ func resourceComputeDisk() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"kms_key_self_link": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareCryptoKeyVersions,
+						},
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -337,6 +351,12 @@ func resourceComputeDisk() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"kms_key_self_link": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareCryptoKeyVersions,
+						},
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -362,6 +382,12 @@ func resourceComputeDisk() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"kms_key_self_link": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareCryptoKeyVersions,
+						},
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -544,6 +570,23 @@ func resourceComputeDiskCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error waiting to create Disk: %s", waitErr)
 	}
 
+	// resourcePolicies is only in the beta API, so the policies are attached
+	// once the disk exists rather than sent with the insert.
+	resourcePolicies, err := expandComputeResourcePolicies(d.Get("resource_policies"), d, config)
+	if err != nil {
+		return err
+	}
+	if len(resourcePolicies) > 0 {
+		url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/disks/{{name}}/addResourcePolicies")
+		if err != nil {
+			return err
+		}
+		err = sendResourcePoliciesRequest(config, project, url, resourcePolicies, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return fmt.Errorf("Error adding resource policies to Disk %q: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Finished creating Disk %q: %#v", d.Id(), res)
 
 	return resourceComputeDiskRead(d, meta)
@@ -633,6 +676,19 @@ func resourceComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading Disk: %s", err)
 	}
 
+	// resourcePolicies is only in the beta API.
+	url, err = replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/disks/{{name}}")
+	if err != nil {
+		return err
+	}
+	res, err = sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("Error reading resource policies of Disk %q: %s", d.Id(), err)
+	}
+	if err := d.Set("resource_policies", flattenComputeDiskResourcePolicies(res["resourcePolicies"])); err != nil {
+		return fmt.Errorf("Error reading Disk: %s", err)
+	}
+
 	return nil
 }
 
@@ -719,6 +775,18 @@ func resourceComputeDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		d.SetPartial("size")
 	}
+	if d.HasChange("resource_policies") {
+		url, err = replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/zones/{{zone}}/disks/{{name}}")
+		if err != nil {
+			return err
+		}
+		err = updateComputeResourcePolicies(d, config, project, url)
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("resource_policies")
+	}
 
 	d.Partial(false)
 
@@ -924,6 +992,8 @@ func flattenComputeDiskSourceImageEncryptionKey(v interface{}) interface{} {
 		flattenComputeDiskSourceImageEncryptionKeyRawKey(original["rawKey"])
 	transformed["sha256"] =
 		flattenComputeDiskSourceImageEncryptionKeySha256(original["sha256"])
+	transformed["kms_key_self_link"] =
+		flattenComputeDiskSourceImageEncryptionKeyKmsKeySelfLink(original["kmsKeyName"])
 	return []interface{}{transformed}
 }
 func flattenComputeDiskSourceImageEncryptionKeyRawKey(v interface{}) interface{} {
@@ -934,6 +1004,10 @@ func flattenComputeDiskSourceImageEncryptionKeySha256(v interface{}) interface{}
 	return v
 }
 
+func flattenComputeDiskSourceImageEncryptionKeyKmsKeySelfLink(v interface{}) interface{} {
+	return v
+}
+
 func flattenComputeDiskSourceImageId(v interface{}) interface{} {
 	return v
 }
@@ -948,6 +1022,8 @@ func flattenComputeDiskDiskEncryptionKey(v interface{}) interface{} {
 		flattenComputeDiskDiskEncryptionKeyRawKey(original["rawKey"])
 	transformed["sha256"] =
 		flattenComputeDiskDiskEncryptionKeySha256(original["sha256"])
+	transformed["kms_key_self_link"] =
+		flattenComputeDiskDiskEncryptionKeyKmsKeySelfLink(original["kmsKeyName"])
 	return []interface{}{transformed}
 }
 func flattenComputeDiskDiskEncryptionKeyRawKey(v interface{}) interface{} {
@@ -958,6 +1034,10 @@ func flattenComputeDiskDiskEncryptionKeySha256(v interface{}) interface{} {
 	return v
 }
 
+func flattenComputeDiskDiskEncryptionKeyKmsKeySelfLink(v interface{}) interface{} {
+	return v
+}
+
 func flattenComputeDiskSnapshot(v interface{}) interface{} {
 	if v == nil {
 		return v
@@ -975,6 +1055,8 @@ func flattenComputeDiskSourceSnapshotEncryptionKey(v interface{}) interface{} {
 		flattenComputeDiskSourceSnapshotEncryptionKeyRawKey(original["rawKey"])
 	transformed["sha256"] =
 		flattenComputeDiskSourceSnapshotEncryptionKeySha256(original["sha256"])
+	transformed["kms_key_self_link"] =
+		flattenComputeDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(original["kmsKeyName"])
 	return []interface{}{transformed}
 }
 func flattenComputeDiskSourceSnapshotEncryptionKeyRawKey(v interface{}) interface{} {
@@ -985,10 +1067,21 @@ func flattenComputeDiskSourceSnapshotEncryptionKeySha256(v interface{}) interfac
 	return v
 }
 
+func flattenComputeDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(v interface{}) interface{} {
+	return v
+}
+
 func flattenComputeDiskSourceSnapshotId(v interface{}) interface{} {
 	return v
 }
 
+func flattenComputeDiskResourcePolicies(v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	return convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)
+}
+
 func expandComputeDiskDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -1045,12 +1138,21 @@ func expandComputeDiskSourceImageEncryptionKey(v interface{}, d *schema.Resource
 	if err != nil {
 		return nil, err
 	}
-	transformed["rawKey"] = transformedRawKey
+	if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !isEmptyValue(val) {
+		transformed["rawKey"] = transformedRawKey
+	}
 	transformedSha256, err := expandComputeDiskSourceImageEncryptionKeySha256(original["sha256"], d, config)
 	if err != nil {
 		return nil, err
 	}
 	transformed["sha256"] = transformedSha256
+	transformedKmsKeySelfLink, err := expandComputeDiskSourceImageEncryptionKeyKmsKeySelfLink(original["kms_key_self_link"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	if val := reflect.ValueOf(transformedKmsKeySelfLink); val.IsValid() && !isEmptyValue(val) {
+		transformed["kmsKeyName"] = transformedKmsKeySelfLink
+	}
 	return transformed, nil
 }
 
@@ -1062,13 +1164,22 @@ func expandComputeDiskSourceImageEncryptionKeySha256(v interface{}, d *schema.Re
 	return v, nil
 }
 
+func expandComputeDiskSourceImageEncryptionKeyKmsKeySelfLink(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandComputeDiskDiskEncryptionKey(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	l := v.([]interface{})
 	req := make([]interface{}, 0, 1)
 	if len(l) == 1 {
 		// There is a value
 		outMap := make(map[string]interface{})
-		outMap["rawKey"] = l[0].(map[string]interface{})["raw_key"]
+		if v := l[0].(map[string]interface{})["raw_key"]; v != "" {
+			outMap["rawKey"] = v
+		}
+		if v := l[0].(map[string]interface{})["kms_key_self_link"]; v != "" {
+			outMap["kmsKeyName"] = v
+		}
 		req = append(req, outMap)
 	} else {
 		// Check alternative setting?
@@ -1102,12 +1213,21 @@ func expandComputeDiskSourceSnapshotEncryptionKey(v interface{}, d *schema.Resou
 	if err != nil {
 		return nil, err
 	}
-	transformed["rawKey"] = transformedRawKey
+	if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !isEmptyValue(val) {
+		transformed["rawKey"] = transformedRawKey
+	}
 	transformedSha256, err := expandComputeDiskSourceSnapshotEncryptionKeySha256(original["sha256"], d, config)
 	if err != nil {
 		return nil, err
 	}
 	transformed["sha256"] = transformedSha256
+	transformedKmsKeySelfLink, err := expandComputeDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(original["kms_key_self_link"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	if val := reflect.ValueOf(transformedKmsKeySelfLink); val.IsValid() && !isEmptyValue(val) {
+		transformed["kmsKeyName"] = transformedKmsKeySelfLink
+	}
 	return transformed, nil
 }
 
@@ -1119,6 +1239,14 @@ func expandComputeDiskSourceSnapshotEncryptionKeySha256(v interface{}, d *schema
 	return v, nil
 }
 
+func expandComputeDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeDiskResourcePolicies(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return expandComputeResourcePolicies(v, d, config)
+}
+
 func resourceComputeDiskEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
 	config := meta.(*Config)
 
@@ -1190,6 +1318,7 @@ func resourceComputeDiskDecoder(d *schema.ResourceData, meta interface{}, res ma
 		// The raw key won't be returned, so we need to use the original.
 		transformed["rawKey"] = d.Get("disk_encryption_key.0.raw_key")
 		transformed["sha256"] = original["sha256"]
+		transformed["kmsKeyName"] = original["kmsKeyName"]
 		if v, ok := d.GetOk("disk_encryption_key_raw"); ok {
 			transformed["rawKey"] = v
 		}
@@ -1203,6 +1332,7 @@ func resourceComputeDiskDecoder(d *schema.ResourceData, meta interface{}, res ma
 		// The raw key won't be returned, so we need to use the original.
 		transformed["rawKey"] = d.Get("source_image_encryption_key.0.raw_key")
 		transformed["sha256"] = original["sha256"]
+		transformed["kmsKeyName"] = original["kmsKeyName"]
 		res["sourceImageEncryptionKey"] = transformed
 	}
 
@@ -1212,6 +1342,7 @@ func resourceComputeDiskDecoder(d *schema.ResourceData, meta interface{}, res ma
 		// The raw key won't be returned, so we need to use the original.
 		transformed["rawKey"] = d.Get("source_snapshot_encryption_key.0.raw_key")
 		transformed["sha256"] = original["sha256"]
+		transformed["kmsKeyName"] = original["kmsKeyName"]
 		res["sourceSnapshotEncryptionKey"] = transformed
 	}
 
@@ -3,12 +3,14 @@ package google
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/compute/v1"
 )
@@ -171,6 +173,34 @@ func TestDiskImageDiffSuppress(t *testing.T) {
 	}
 }
 
+func TestExpandComputeDiskEncryptionKeys(t *testing.T) {
+	kmsKey := "projects/p/locations/global/keyRings/r/cryptoKeys/k"
+	d := schema.TestResourceDataRaw(t, resourceComputeDisk().Schema, map[string]interface{}{
+		"name": "disk",
+	})
+
+	diskKey, err := expandComputeDiskDiskEncryptionKey([]interface{}{
+		map[string]interface{}{"raw_key": "", "kms_key_self_link": kmsKey},
+	}, d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []interface{}{map[string]interface{}{"kmsKeyName": kmsKey}}
+	if !reflect.DeepEqual(diskKey, expected) {
+		t.Errorf("bad disk_encryption_key: expected %#v, got %#v", expected, diskKey)
+	}
+
+	snapshotKey, err := expandComputeDiskSourceSnapshotEncryptionKey([]interface{}{
+		map[string]interface{}{"raw_key": "SGVsbG8gZnJvbSBHb29nbGUgQ2xvdWQgUGxhdGZvcm0=", "sha256": "", "kms_key_self_link": ""},
+	}, d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := snapshotKey.(map[string]interface{})["kmsKeyName"]; ok {
+		t.Errorf("bad source_snapshot_encryption_key, kmsKeyName shouldn't be sent with a raw key: %#v", snapshotKey)
+	}
+}
+
 // Test that all the naming pattern for public images are supported.
 func TestAccComputeDisk_imageDiffSuppressPublicVendorsFamilyNames(t *testing.T) {
 	t.Parallel()
@@ -356,6 +386,72 @@ func TestAccComputeDisk_encryption(t *testing.T) {
 	})
 }
 
+func TestAccComputeDisk_encryptionKMS(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	keyRingName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	var disk compute.Disk
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeDisk_encryptionKMS(keyRingName, diskName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeDiskExists(
+						"google_compute_disk.foobar", &disk),
+					resource.TestMatchResourceAttr("google_compute_disk.foobar",
+						"disk_encryption_key.0.kms_key_self_link", regexp.MustCompile("/cryptoKeyVersions/1$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeDisk_resourcePolicies(t *testing.T) {
+	t.Parallel()
+
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	policyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeDisk_resourcePolicies(diskName, policyName, false),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_disk.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Attaching the policy doesn't recreate the disk.
+			resource.TestStep{
+				Config: testAccComputeDisk_resourcePolicies(diskName, policyName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_disk.foobar", "resource_policies.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_disk.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeDisk_resourcePolicies(diskName, policyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_disk.foobar", "resource_policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeDisk_deleteDetach(t *testing.T) {
 	t.Parallel()
 
@@ -680,6 +776,76 @@ resource "google_compute_disk" "foobar" {
 }`, diskName)
 }
 
+func testAccComputeDisk_encryptionKMS(keyRingName, diskName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "google_compute_disk" "foobar" {
+	name = "%s"
+	image = "debian-8-jessie-v20160803"
+	size = 50
+	type = "pd-ssd"
+	zone = "us-central1-a"
+	disk_encryption_key {
+		kms_key_self_link = "${google_kms_crypto_key.foobar.self_link}"
+	}
+	depends_on = ["google_kms_crypto_key_iam_member.compute"]
+}`, testAccComputeKmsKey(keyRingName), diskName)
+}
+
+// testAccComputeKmsKey returns a KMS crypto key the Compute Engine service
+// agent of the test project can encrypt disks with.
+func testAccComputeKmsKey(keyRingName string) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {}
+
+resource "google_kms_key_ring" "foobar" {
+	name = "%s"
+	location = "us-central1"
+}
+
+resource "google_kms_crypto_key" "foobar" {
+	name = "%s"
+	key_ring = "${google_kms_key_ring.foobar.id}"
+}
+
+resource "google_kms_crypto_key_iam_member" "compute" {
+	crypto_key_id = "${google_kms_crypto_key.foobar.self_link}"
+	role = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+	member = "serviceAccount:service-${data.google_project.project.number}@compute-system.iam.gserviceaccount.com"
+}`, keyRingName, keyRingName)
+}
+
+func testAccComputeDisk_resourcePolicies(diskName, policyName string, attached bool) string {
+	policies := "[]"
+	if attached {
+		policies = `["${google_compute_resource_policy.foo.self_link}"]`
+	}
+
+	return fmt.Sprintf(`
+resource "google_compute_resource_policy" "foo" {
+	name = "%s"
+	region = "us-central1"
+	snapshot_schedule_policy {
+		schedule {
+			daily_schedule {
+				days_in_cycle = 1
+				start_time = "04:00"
+			}
+		}
+	}
+}
+
+resource "google_compute_disk" "foobar" {
+	name = "%s"
+	image = "debian-8-jessie-v20160803"
+	size = 50
+	type = "pd-ssd"
+	zone = "us-central1-a"
+	resource_policies = %s
+}`, policyName, diskName, policies)
+}
+
 func testAccComputeDisk_encryptionMigrate(diskName string) string {
 	return fmt.Sprintf(`
 resource "google_compute_disk" "foobar" {
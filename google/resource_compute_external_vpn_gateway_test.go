@@ -0,0 +1,118 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeExternalVpnGateway_basic(t *testing.T) {
+	t.Parallel()
+
+	gatewayName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeExternalVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeExternalVpnGateway_basic(gatewayName),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_external_vpn_gateway.external_gateway",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeExternalVpnGateway_twoInterfaces(t *testing.T) {
+	t.Parallel()
+
+	gatewayName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeExternalVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeExternalVpnGateway_twoInterfaces(gatewayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_compute_external_vpn_gateway.external_gateway", "interface.#", "2"),
+					resource.TestCheckResourceAttr(
+						"google_compute_external_vpn_gateway.external_gateway", "interface.1.id", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_external_vpn_gateway.external_gateway",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeExternalVpnGatewayDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_external_vpn_gateway" {
+			continue
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		name := rs.Primary.Attributes["name"]
+
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/global/externalVpnGateways/%s", project, name)
+		if _, err := sendRequest(config, "GET", url, nil); err == nil {
+			return fmt.Errorf("Error, External VPN Gateway %s still exists", name)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeExternalVpnGateway_basic(gatewayName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_external_vpn_gateway" "external_gateway" {
+  name            = "%s"
+  redundancy_type = "SINGLE_IP_INTERNALLY_REDUNDANT"
+  description     = "An externally managed VPN gateway"
+
+  interface {
+    id         = 0
+    ip_address = "8.8.8.8"
+  }
+}
+`, gatewayName)
+}
+
+func testAccComputeExternalVpnGateway_twoInterfaces(gatewayName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_external_vpn_gateway" "external_gateway" {
+  name            = "%s"
+  redundancy_type = "TWO_IPS_REDUNDANCY"
+
+  interface {
+    id         = 0
+    ip_address = "8.8.8.8"
+  }
+
+  interface {
+    id         = 1
+    ip_address = "8.8.4.4"
+  }
+}
+`, gatewayName)
+}
@@ -0,0 +1,82 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeHaVpnGateway_basic(t *testing.T) {
+	t.Parallel()
+
+	gatewayName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeHaVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeHaVpnGateway_basic(gatewayName, networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_compute_ha_vpn_gateway.ha_gateway", "vpn_interfaces.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"google_compute_ha_vpn_gateway.ha_gateway", "vpn_interfaces.0.ip_address"),
+					resource.TestCheckResourceAttrSet(
+						"google_compute_ha_vpn_gateway.ha_gateway", "vpn_interfaces.1.ip_address"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_ha_vpn_gateway.ha_gateway",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeHaVpnGatewayDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_ha_vpn_gateway" {
+			continue
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		region := rs.Primary.Attributes["region"]
+		name := rs.Primary.Attributes["name"]
+
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/regions/%s/vpnGateways/%s", project, region, name)
+		if _, err := sendRequest(config, "GET", url, nil); err == nil {
+			return fmt.Errorf("Error, HA VPN Gateway %s in region %s still exists", name, region)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeHaVpnGateway_basic(gatewayName, networkName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_ha_vpn_gateway" "ha_gateway" {
+  region      = "us-central1"
+  name        = "%s"
+  network     = "${google_compute_network.network.self_link}"
+  description = "example HA VPN gateway"
+}
+
+resource "google_compute_network" "network" {
+  name                    = "%s"
+  auto_create_subnetworks = false
+}
+`, gatewayName, networkName)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
 )
 
@@ -88,6 +89,48 @@ func resourceComputeImage() *schema.Resource {
 				},
 			},
 
+			"image_encryption_key_raw": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"image_encryption_kms_key_self_link"},
+			},
+
+			"image_encryption_kms_key_self_link": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareCryptoKeyVersions,
+				ConflictsWith:    []string{"image_encryption_key_raw"},
+			},
+
+			"image_encryption_key_sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_disk_encryption_key_raw": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"source_disk_encryption_kms_key_self_link"},
+			},
+
+			"source_disk_encryption_kms_key_self_link": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareCryptoKeyVersions,
+				ConflictsWith:    []string{"source_disk_encryption_key_raw"},
+			},
+
+			"source_disk_encryption_key_sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"self_link": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -131,7 +174,7 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Build the image
-	image := &compute.Image{
+	image := &computeBeta.Image{
 		Name: d.Get("name").(string),
 	}
 
@@ -151,7 +194,7 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 	// Load up the raw_disk for this image if specified
 	if v, ok := d.GetOk("raw_disk"); ok {
 		rawDiskEle := v.([]interface{})[0].(map[string]interface{})
-		imageRawDisk := &compute.ImageRawDisk{
+		imageRawDisk := &computeBeta.ImageRawDisk{
 			Source:        rawDiskEle["source"].(string),
 			ContainerType: rawDiskEle["container_type"].(string),
 		}
@@ -162,6 +205,26 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 		image.RawDisk = imageRawDisk
 	}
 
+	if v, ok := d.GetOk("image_encryption_key_raw"); ok {
+		image.ImageEncryptionKey = &computeBeta.CustomerEncryptionKey{}
+		image.ImageEncryptionKey.RawKey = v.(string)
+	}
+
+	if v, ok := d.GetOk("image_encryption_kms_key_self_link"); ok {
+		image.ImageEncryptionKey = &computeBeta.CustomerEncryptionKey{}
+		image.ImageEncryptionKey.KmsKeyName = v.(string)
+	}
+
+	if v, ok := d.GetOk("source_disk_encryption_key_raw"); ok {
+		image.SourceDiskEncryptionKey = &computeBeta.CustomerEncryptionKey{}
+		image.SourceDiskEncryptionKey.RawKey = v.(string)
+	}
+
+	if v, ok := d.GetOk("source_disk_encryption_kms_key_self_link"); ok {
+		image.SourceDiskEncryptionKey = &computeBeta.CustomerEncryptionKey{}
+		image.SourceDiskEncryptionKey.KmsKeyName = v.(string)
+	}
+
 	if _, ok := d.GetOk("labels"); ok {
 		image.Labels = expandLabels(d)
 	}
@@ -180,7 +243,7 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	// Insert the image
-	op, err := config.clientCompute.Images.Insert(
+	op, err := config.clientComputeBeta.Images.Insert(
 		project, image).Do()
 	if err != nil {
 		return fmt.Errorf("Error creating image: %s", err)
@@ -189,7 +252,7 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 	// Store the ID
 	d.SetId(image.Name)
 
-	err = computeOperationWaitTime(config.clientCompute, op, project, "Creating Image", createTimeout)
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, createTimeout, "Creating Image")
 	if err != nil {
 		return err
 	}
@@ -205,7 +268,7 @@ func resourceComputeImageRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	image, err := config.clientCompute.Images.Get(
+	image, err := config.clientComputeBeta.Images.Get(
 		project, d.Id()).Do()
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Image %q", d.Get("name").(string)))
@@ -230,6 +293,21 @@ func resourceComputeImageRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("label_fingerprint", image.LabelFingerprint)
 	d.Set("project", project)
 
+	if image.ImageEncryptionKey != nil {
+		if image.ImageEncryptionKey.Sha256 != "" {
+			d.Set("image_encryption_key_sha256", image.ImageEncryptionKey.Sha256)
+		}
+		// The crypto key version in use is returned
+		d.Set("image_encryption_kms_key_self_link", image.ImageEncryptionKey.KmsKeyName)
+	}
+
+	if image.SourceDiskEncryptionKey != nil {
+		if image.SourceDiskEncryptionKey.Sha256 != "" {
+			d.Set("source_disk_encryption_key_sha256", image.SourceDiskEncryptionKey.Sha256)
+		}
+		d.Set("source_disk_encryption_kms_key_self_link", image.SourceDiskEncryptionKey.KmsKeyName)
+	}
+
 	return nil
 }
 
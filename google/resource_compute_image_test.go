@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -133,6 +134,35 @@ func TestAccComputeImage_basedondisk(t *testing.T) {
 	})
 }
 
+func TestAccComputeImage_encryptionKMS(t *testing.T) {
+	t.Parallel()
+
+	keyRingName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	var image compute.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeImageDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeImage_encryptionKMS(keyRingName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeImageExists(
+						"google_compute_image.foobar", &image),
+					resource.TestMatchResourceAttr("google_compute_image.foobar",
+						"image_encryption_kms_key_self_link", regexp.MustCompile("/cryptoKeyVersions/1$")),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_image.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeImageDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -331,3 +361,21 @@ resource "google_compute_image" "foobar" {
 	source_disk = "${google_compute_disk.foobar.self_link}"
 }`, acctest.RandString(10), acctest.RandString(10))
 }
+
+func testAccComputeImage_encryptionKMS(keyRingName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "google_compute_disk" "foobar" {
+	name = "disk-test-%s"
+	zone = "us-central1-a"
+	image = "debian-8-jessie-v20160803"
+}
+
+resource "google_compute_image" "foobar" {
+	name = "image-test-%s"
+	source_disk = "${google_compute_disk.foobar.self_link}"
+	image_encryption_kms_key_self_link = "${google_kms_crypto_key.foobar.self_link}"
+	depends_on = ["google_kms_crypto_key_iam_member.compute"]
+}`, testAccComputeKmsKey(keyRingName), acctest.RandString(10), acctest.RandString(10))
+}
@@ -64,10 +64,11 @@ func resourceComputeInstance() *schema.Resource {
 						},
 
 						"disk_encryption_key_raw": &schema.Schema{
-							Type:      schema.TypeString,
-							Optional:  true,
-							ForceNew:  true,
-							Sensitive: true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							Sensitive:     true,
+							ConflictsWith: []string{"boot_disk.0.kms_key_self_link"},
 						},
 
 						"disk_encryption_key_sha256": &schema.Schema{
@@ -75,6 +76,15 @@ func resourceComputeInstance() *schema.Resource {
 							Computed: true,
 						},
 
+						"kms_key_self_link": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ConflictsWith:    []string{"boot_disk.0.disk_encryption_key_raw"},
+							DiffSuppressFunc: compareCryptoKeyVersions,
+						},
+
 						"initialize_params": &schema.Schema{
 							Type:     schema.TypeList,
 							Optional: true,
@@ -277,6 +287,13 @@ func resourceComputeInstance() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+
+						"kms_key_self_link": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: compareCryptoKeyVersions,
+						},
 					},
 				},
 			},
@@ -488,10 +505,19 @@ func resourceComputeInstance() *schema.Resource {
 							Default:  false,
 							ForceNew: true,
 						},
+
+						"node_affinities": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     instanceSchedulingNodeAffinitiesElemSchema(),
+						},
 					},
 				},
 			},
 
+			"reservation_affinity": schemaReservationAffinity(),
+
 			"scratch_disk": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -671,6 +697,7 @@ func expandComputeInstance(project string, zone *compute.Zone, d *schema.Resourc
 		AutomaticRestart:  googleapi.Bool(d.Get(prefix + ".automatic_restart").(bool)),
 		Preemptible:       d.Get(prefix + ".preemptible").(bool),
 		OnHostMaintenance: d.Get(prefix + ".on_host_maintenance").(string),
+		NodeAffinities:    expandNodeAffinities(d.Get(prefix + ".node_affinities")),
 		ForceSendFields:   []string{"AutomaticRestart", "Preemptible"},
 	}
 
@@ -742,7 +769,13 @@ func resourceComputeInstanceCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	log.Printf("[INFO] Requesting instance creation")
-	op, err := config.clientComputeBeta.Instances.Insert(project, zone.Name, instance).Do()
+	var op interface{}
+	if reservationAffinity := expandReservationAffinity(d.Get("reservation_affinity")); reservationAffinity != nil {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/instances", project, zone.Name)
+		op, err = insertComputeWithReservationAffinity(config, url, instance, reservationAffinity)
+	} else {
+		op, err = config.clientComputeBeta.Instances.Insert(project, zone.Name, instance).Do()
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating instance: %s", err)
 	}
@@ -906,6 +939,7 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 					di["disk_encryption_key_raw"] = d.Get(fmt.Sprintf("attached_disk.%d.disk_encryption_key_raw", adIndex))
 				}
 				di["disk_encryption_key_sha256"] = key.Sha256
+				di["kms_key_self_link"] = key.KmsKeyName
 			}
 			// We want the disks to remain in the order we set in the config, so if a disk
 			// is present in the config, make sure it's at the correct index. Otherwise, append it.
@@ -929,6 +963,14 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("attached_disk", ads)
 	d.Set("scratch_disk", scratchDisks)
 	d.Set("scheduling", flattenScheduling(instance.Scheduling))
+
+	reservationAffinity, err := readReservationAffinity(config, instance.SelfLink)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("reservation_affinity", reservationAffinity); err != nil {
+		return fmt.Errorf("Error setting reservation_affinity: %s", err)
+	}
 	d.Set("guest_accelerator", flattenGuestAccelerators(instance.GuestAccelerators))
 	d.Set("cpu_platform", instance.CpuPlatform)
 	d.Set("min_cpu_platform", instance.MinCpuPlatform)
@@ -1061,21 +1103,22 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 	if d.HasChange("scheduling") {
 		prefix := "scheduling.0"
-		scheduling := &compute.Scheduling{
+		scheduling := &computeBeta.Scheduling{
 			AutomaticRestart:  googleapi.Bool(d.Get(prefix + ".automatic_restart").(bool)),
 			Preemptible:       d.Get(prefix + ".preemptible").(bool),
 			OnHostMaintenance: d.Get(prefix + ".on_host_maintenance").(string),
+			NodeAffinities:    expandNodeAffinities(d.Get(prefix + ".node_affinities")),
 			ForceSendFields:   []string{"AutomaticRestart", "Preemptible"},
 		}
 
-		op, err := config.clientCompute.Instances.SetScheduling(project,
+		op, err := config.clientComputeBeta.Instances.SetScheduling(project,
 			zone, d.Id(), scheduling).Do()
 
 		if err != nil {
 			return fmt.Errorf("Error updating scheduling policy: %s", err)
 		}
 
-		opErr := computeOperationWaitTime(config.clientCompute, op, project, "scheduling policy update", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+		opErr := computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutUpdate).Minutes()), "scheduling policy update")
 		if opErr != nil {
 			return opErr
 		}
@@ -1448,6 +1491,12 @@ func expandAttachedDisk(diskConfig map[string]interface{}, d *schema.ResourceDat
 			RawKey: v.(string),
 		}
 	}
+
+	if v, ok := diskConfig["kms_key_self_link"]; ok && v.(string) != "" {
+		disk.DiskEncryptionKey = &computeBeta.CustomerEncryptionKey{
+			KmsKeyName: v.(string),
+		}
+	}
 	return disk, nil
 }
 
@@ -1583,6 +1632,12 @@ func expandBootDisk(d *schema.ResourceData, config *Config, zone *compute.Zone,
 		}
 	}
 
+	if v, ok := d.GetOk("boot_disk.0.kms_key_self_link"); ok {
+		disk.DiskEncryptionKey = &computeBeta.CustomerEncryptionKey{
+			KmsKeyName: v.(string),
+		}
+	}
+
 	if v, ok := d.GetOk("boot_disk.0.source"); ok {
 		source, err := ParseDiskFieldValue(v.(string), d, config)
 		if err != nil {
@@ -1653,6 +1708,8 @@ func flattenBootDisk(d *schema.ResourceData, disk *computeBeta.AttachedDisk, con
 
 	if disk.DiskEncryptionKey != nil {
 		result["disk_encryption_key_sha256"] = disk.DiskEncryptionKey.Sha256
+		// The crypto key version in use is returned
+		result["kms_key_self_link"] = disk.DiskEncryptionKey.KmsKeyName
 	}
 
 	return []map[string]interface{}{result}
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
@@ -179,6 +178,26 @@ func resourceComputeInstanceGroupManager() *schema.Resource {
 				},
 			},
 
+			"stateful_disk": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"delete_rule": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NEVER",
+							ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+						},
+					},
+				},
+			},
+
 			"rolling_update_policy": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -239,6 +258,13 @@ func resourceComputeInstanceGroupManager() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+
+			"wait_for_instances_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "STABLE",
+				ValidateFunc: validation.StringInSlice([]string{"STABLE", "UPDATED"}, false),
+			},
 		},
 	}
 }
@@ -286,6 +312,10 @@ func resourceComputeInstanceGroupManagerCreate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("[rolling_update_policy] must be set when 'update_strategy' is set to 'ROLLING_UPDATE'")
 	}
 
+	if err := validateStatefulUpdatePolicy(d); err != nil {
+		return err
+	}
+
 	// Build the parameter
 	manager := &computeBeta.InstanceGroupManager{
 		Name:                d.Get("name").(string),
@@ -302,8 +332,14 @@ func resourceComputeInstanceGroupManagerCreate(d *schema.ResourceData, meta inte
 	}
 
 	log.Printf("[DEBUG] InstanceGroupManager insert request: %#v", manager)
-	op, err := config.clientComputeBeta.InstanceGroupManagers.Insert(
-		project, zone, manager).Do()
+	var op *computeBeta.Operation
+	if d.Get("stateful_disk").(*schema.Set).Len() > 0 {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/instanceGroupManagers", project, zone)
+		op, err = insertInstanceGroupManagerWithStatefulPolicy(d, config, url, manager)
+	} else {
+		op, err = config.clientComputeBeta.InstanceGroupManagers.Insert(
+			project, zone, manager).Do()
+	}
 
 	if err != nil {
 		return fmt.Errorf("Error creating InstanceGroupManager: %s", err)
@@ -318,6 +354,10 @@ func resourceComputeInstanceGroupManagerCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
+	if err := waitForInstancesUpdated(getManager, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	return resourceComputeInstanceGroupManagerRead(d, meta)
 }
 
@@ -440,15 +480,16 @@ func resourceComputeInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 	d.Set("update_strategy", update_strategy.(string))
 	d.Set("auto_healing_policies", flattenAutoHealingPolicies(manager.AutoHealingPolicies))
 
-	if d.Get("wait_for_instances").(bool) {
-		conf := resource.StateChangeConf{
-			Pending: []string{"creating", "error"},
-			Target:  []string{"created"},
-			Refresh: waitForInstancesRefreshFunc(getManager, d, meta),
-			Timeout: d.Timeout(schema.TimeoutCreate),
-		}
-		_, err := conf.WaitForState()
-		if err != nil {
+	statefulDisks, err := readStatefulDisks(config, manager.SelfLink)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("stateful_disk", statefulDisks); err != nil {
+		return err
+	}
+
+	if d.Get("wait_for_instances").(bool) && d.Get("wait_for_instances_status").(string) == "STABLE" {
+		if err := waitForInstancesStable(getManager, d, meta); err != nil {
 			return err
 		}
 	}
@@ -532,6 +573,10 @@ func resourceComputeInstanceGroupManagerUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("[rolling_update_policy] must be set when 'update_strategy' is set to 'ROLLING_UPDATE'")
 	}
 
+	if err := validateStatefulUpdatePolicy(d); err != nil {
+		return err
+	}
+
 	// If target_pools changes then update
 	if d.HasChange("target_pools") {
 		targetPools := convertStringSet(d.Get("target_pools").(*schema.Set))
@@ -625,6 +670,17 @@ func resourceComputeInstanceGroupManagerUpdate(d *schema.ResourceData, meta inte
 		d.SetPartial("auto_healing_policies")
 	}
 
+	// Update the preserved state before the instances are updated, so they
+	// keep it.
+	if d.HasChange("stateful_disk") {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/instanceGroupManagers/%s", project, zone, d.Id())
+		if err := patchStatefulPolicy(d, config, project, url); err != nil {
+			return err
+		}
+
+		d.SetPartial("stateful_disk")
+	}
+
 	// If instance_template changes then update
 	if d.HasChange("instance_template") {
 		// Build the parameter
@@ -665,6 +721,10 @@ func resourceComputeInstanceGroupManagerUpdate(d *schema.ResourceData, meta inte
 
 	d.Partial(false)
 
+	if err := waitForInstancesUpdated(getManager, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	return resourceComputeInstanceGroupManagerRead(d, meta)
 }
 
@@ -826,5 +886,6 @@ func flattenAutoHealingPolicies(autoHealingPolicies []*computeBeta.InstanceGroup
 
 func resourceInstanceGroupManagerStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("wait_for_instances", false)
+	d.Set("wait_for_instances_status", "STABLE")
 	return []*schema.ResourceData{d}, nil
 }
@@ -360,6 +360,70 @@ func TestAccInstanceGroupManager_selfLinkStability(t *testing.T) {
 	})
 }
 
+func TestAccInstanceGroupManager_waitForInstancesUpdated(t *testing.T) {
+	t.Parallel()
+
+	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	hck := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceGroupManagerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceGroupManager_waitForInstancesUpdated(template, igm, hck),
+			},
+			resource.TestStep{
+				ResourceName:            "google_compute_instance_group_manager.igm-updated",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_instances", "wait_for_instances_status"},
+			},
+		},
+	})
+}
+
+func TestAccInstanceGroupManager_statefulDisks(t *testing.T) {
+	t.Parallel()
+
+	template := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceGroupManagerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceGroupManager_statefulDisks(template, igm, "NEVER"),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_instance_group_manager.igm-stateful",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccInstanceGroupManager_statefulDisks(template, igm, "ON_PERMANENT_INSTANCE_DELETION"),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_instance_group_manager.igm-stateful",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccInstanceGroupManager_statelessDisks(template, igm),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_instance_group_manager.igm-stateful",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceGroupManagerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -1187,6 +1251,124 @@ resource "google_compute_http_health_check" "zero" {
 	`, template, target, igm, hck)
 }
 
+func testAccInstanceGroupManager_waitForInstancesUpdated(template, igm, hck string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_template" "igm-updated" {
+	name = "%s"
+	machine_type = "n1-standard-1"
+	disk {
+		source_image = "debian-cloud/debian-9"
+		auto_delete = true
+		boot = true
+	}
+	network_interface {
+		network = "default"
+	}
+}
+
+resource "google_compute_health_check" "ssh" {
+	name = "%s"
+	check_interval_sec = 5
+	timeout_sec = 5
+	tcp_health_check {
+		port = 22
+	}
+}
+
+resource "google_compute_instance_group_manager" "igm-updated" {
+	description = "Terraform test instance group manager"
+	name = "%s"
+	instance_template = "${google_compute_instance_template.igm-updated.self_link}"
+	base_instance_name = "igm-updated"
+	zone = "us-central1-c"
+	target_size = 2
+	auto_healing_policies {
+		health_check = "${google_compute_health_check.ssh.self_link}"
+		initial_delay_sec = 300
+	}
+	wait_for_instances = true
+	wait_for_instances_status = "UPDATED"
+}
+	`, template, hck, igm)
+}
+
+func testAccInstanceGroupManager_statefulDisks(template, igm, deleteRule string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_template" "igm-stateful" {
+	name = "%s"
+	machine_type = "n1-standard-1"
+	disk {
+		source_image = "debian-cloud/debian-9"
+		auto_delete = true
+		boot = true
+		device_name = "boot"
+	}
+	disk {
+		disk_type = "pd-standard"
+		disk_size_gb = 10
+		auto_delete = true
+		device_name = "data"
+	}
+	network_interface {
+		network = "default"
+	}
+}
+
+resource "google_compute_instance_group_manager" "igm-stateful" {
+	description = "Terraform test instance group manager"
+	name = "%s"
+	instance_template = "${google_compute_instance_template.igm-stateful.self_link}"
+	base_instance_name = "igm-stateful"
+	zone = "us-central1-c"
+	target_size = 1
+	stateful_disk {
+		device_name = "data"
+		delete_rule = "%s"
+	}
+	update_strategy = "ROLLING_UPDATE"
+	rolling_update_policy {
+		type = "PROACTIVE"
+		minimal_action = "REPLACE"
+		max_surge_fixed = 0
+		max_unavailable_fixed = 1
+	}
+}
+	`, template, igm, deleteRule)
+}
+
+func testAccInstanceGroupManager_statelessDisks(template, igm string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_template" "igm-stateful" {
+	name = "%s"
+	machine_type = "n1-standard-1"
+	disk {
+		source_image = "debian-cloud/debian-9"
+		auto_delete = true
+		boot = true
+		device_name = "boot"
+	}
+	disk {
+		disk_type = "pd-standard"
+		disk_size_gb = 10
+		auto_delete = true
+		device_name = "data"
+	}
+	network_interface {
+		network = "default"
+	}
+}
+
+resource "google_compute_instance_group_manager" "igm-stateful" {
+	description = "Terraform test instance group manager"
+	name = "%s"
+	instance_template = "${google_compute_instance_template.igm-stateful.self_link}"
+	base_instance_name = "igm-stateful"
+	zone = "us-central1-c"
+	target_size = 1
+}
+	`, template, igm)
+}
+
 func testAccInstanceGroupManager_versions(primaryTemplate string, canaryTemplate string, igm string) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance_template" "igm-primary" {
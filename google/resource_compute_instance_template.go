@@ -129,6 +129,23 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 							ForceNew: true,
 							Computed: true,
 						},
+
+						"disk_encryption_key": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_self_link": &schema.Schema{
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										DiffSuppressFunc: compareCryptoKeyVersions,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -301,6 +318,8 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 				Computed: true,
 			},
 
+			"reservation_affinity": schemaReservationAffinity(),
+
 			"scheduling": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -328,6 +347,13 @@ func resourceComputeInstanceTemplate() *schema.Resource {
 							Computed: true,
 							ForceNew: true,
 						},
+
+						"node_affinities": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     instanceSchedulingNodeAffinitiesElemSchema(),
+						},
 					},
 				},
 			},
@@ -446,6 +472,12 @@ func buildDisks(d *schema.ResourceData, config *Config) ([]*computeBeta.Attached
 			disk.DeviceName = v.(string)
 		}
 
+		if v, ok := d.GetOk(prefix + ".disk_encryption_key.0.kms_key_self_link"); ok {
+			disk.DiskEncryptionKey = &computeBeta.CustomerEncryptionKey{
+				KmsKeyName: v.(string),
+			}
+		}
+
 		if v, ok := d.GetOk(prefix + ".source"); ok {
 			disk.Source = v.(string)
 		} else {
@@ -588,6 +620,10 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 				forceSendFieldsScheduling = append(forceSendFieldsScheduling, "OnHostMaintenance")
 			}
 		}
+
+		if vp, okp := _scheduling["node_affinities"]; okp {
+			instanceProperties.Scheduling.NodeAffinities = expandNodeAffinities(vp)
+		}
 	}
 	instanceProperties.Scheduling.ForceSendFields = forceSendFieldsScheduling
 
@@ -614,7 +650,13 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 		Name:        itName,
 	}
 
-	op, err := config.clientComputeBeta.InstanceTemplates.Insert(project, instanceTemplate).Do()
+	var op interface{}
+	if reservationAffinity := expandReservationAffinity(d.Get("reservation_affinity")); reservationAffinity != nil {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/global/instanceTemplates", project)
+		op, err = insertComputeWithReservationAffinity(config, url, instanceTemplate, reservationAffinity, "properties")
+	} else {
+		op, err = config.clientComputeBeta.InstanceTemplates.Insert(project, instanceTemplate).Do()
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating instance template: %s", err)
 	}
@@ -652,6 +694,11 @@ func flattenDisks(disks []*computeBeta.AttachedDisk, d *schema.ResourceData) []m
 		diskMap["source"] = disk.Source
 		diskMap["mode"] = disk.Mode
 		diskMap["type"] = disk.Type
+		if disk.DiskEncryptionKey != nil {
+			diskMap["disk_encryption_key"] = []map[string]interface{}{{
+				"kms_key_self_link": disk.DiskEncryptionKey.KmsKeyName,
+			}}
+		}
 		result = append(result, diskMap)
 	}
 	return result
@@ -751,6 +798,13 @@ func resourceComputeInstanceTemplateRead(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("Error setting scheduling: %s", err)
 		}
 	}
+	reservationAffinity, err := readReservationAffinity(config, instanceTemplate.SelfLink, "properties")
+	if err != nil {
+		return err
+	}
+	if err = d.Set("reservation_affinity", reservationAffinity); err != nil {
+		return fmt.Errorf("Error setting reservation_affinity: %s", err)
+	}
 	if instanceTemplate.Properties.Tags != nil {
 		if err = d.Set("tags", instanceTemplate.Properties.Tags.Items); err != nil {
 			return fmt.Errorf("Error setting tags: %s", err)
@@ -435,6 +435,54 @@ func TestAccComputeInstanceTemplate_minCpuPlatform(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstanceTemplate_soleTenantNodeAffinities(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeInstanceTemplate_soleTenantInstanceTemplate(acctest.RandString(10)),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_instance_template.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeInstanceTemplate_reservationAffinity(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeInstanceTemplate_reservationAffinity(acctest.RandString(10), "NO_RESERVATION"),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_instance_template.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeInstanceTemplate_reservationAffinity(acctest.RandString(10), "ANY_RESERVATION"),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_instance_template.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeInstanceTemplateDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -995,6 +1043,7 @@ func testAccComputeInstanceTemplate_subnet_xpn(org, billingId, projectName strin
 		project_id = "%s-host"
 		org_id = "%s"
 		billing_account = "%s"
+		deletion_policy = "DELETE"
 	}
 
 	resource "google_project_service" "host_project" {
@@ -1011,6 +1060,7 @@ func testAccComputeInstanceTemplate_subnet_xpn(org, billingId, projectName strin
 		project_id = "%s-service"
 		org_id = "%s"
 		billing_account = "%s"
+		deletion_policy = "DELETE"
 	}
 
 	resource "google_project_service" "service_project" {
@@ -1187,6 +1237,56 @@ resource "google_compute_instance_template" "foobar" {
 }`, i, count)
 }
 
+func testAccComputeInstanceTemplate_soleTenantInstanceTemplate(i string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_template" "foobar" {
+	name = "instance-test-%s"
+	machine_type = "n1-standard-1"
+
+	disk {
+		source_image = "debian-cloud/debian-9"
+		auto_delete = true
+		boot = true
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	scheduling {
+		preemptible = false
+		automatic_restart = true
+		node_affinities {
+			key = "tfacc"
+			operator = "IN"
+			values = ["testinstancetemplate"]
+		}
+	}
+}`, i)
+}
+
+func testAccComputeInstanceTemplate_reservationAffinity(i, consumeReservationType string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_template" "foobar" {
+	name = "instance-test-%s"
+	machine_type = "n1-standard-1"
+
+	disk {
+		source_image = "debian-cloud/debian-9"
+		auto_delete = true
+		boot = true
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	reservation_affinity {
+		type = "%s"
+	}
+}`, i, consumeReservationType)
+}
+
 func testAccComputeInstanceTemplate_minCpuPlatform(i string) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance_template" "foobar" {
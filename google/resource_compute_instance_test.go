@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -458,6 +459,41 @@ func TestAccComputeInstance_bootDisk_source(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_kmsDiskEncryption(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+	var bootDiskName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+	var diskName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+	var keyRingName = fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeInstance_kmsDiskEncryption(keyRingName, bootDiskName, diskName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(
+						"google_compute_instance.foobar", &instance),
+					resource.TestMatchResourceAttr("google_compute_instance.foobar",
+						"boot_disk.0.kms_key_self_link", regexp.MustCompile("/cryptoKeyVersions/1$")),
+					resource.TestMatchResourceAttr("google_compute_instance.foobar",
+						"attached_disk.0.kms_key_self_link", regexp.MustCompile("/cryptoKeyVersions/1$")),
+				),
+			},
+			// The keys are only set on the disks, so the instance must not
+			// see a diff for them.
+			resource.TestStep{
+				Config:   testAccComputeInstance_kmsDiskEncryption(keyRingName, bootDiskName, diskName, instanceName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccComputeInstance_bootDisk_sourceUrl(t *testing.T) {
 	t.Parallel()
 
@@ -954,6 +990,54 @@ func TestAccComputeInstance_minCpuPlatform(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_soleTenantNodeAffinities(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	templateName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	groupName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeInstance_soleTenantNodeAffinities(instanceName, templateName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+		},
+	})
+}
+
+func TestAccComputeInstance_reservationAffinities(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	instanceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+	reservationName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeInstance_reservationAffinities(instanceName, reservationName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("google_compute_instance.foobar", &instance),
+					resource.TestCheckResourceAttr("google_compute_instance.foobar", "reservation_affinity.0.type", "SPECIFIC_RESERVATION"),
+				),
+			},
+			computeInstanceImportStep("us-central1-a", instanceName, []string{}),
+		},
+	})
+}
+
 func TestAccComputeInstance_deletionProtectionExplicitFalse(t *testing.T) {
 	t.Parallel()
 
@@ -2219,6 +2303,50 @@ resource "google_compute_instance" "foobar" {
 `, disk, instance)
 }
 
+func testAccComputeInstance_kmsDiskEncryption(keyRing, bootDisk, disk, instance string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "google_compute_disk" "boot" {
+	name  = "%s"
+	zone  = "us-central1-a"
+	image = "debian-8-jessie-v20160803"
+	disk_encryption_key {
+		kms_key_self_link = "${google_kms_crypto_key.foobar.self_link}"
+	}
+	depends_on = ["google_kms_crypto_key_iam_member.compute"]
+}
+
+resource "google_compute_disk" "foobar" {
+	name = "%s"
+	zone = "us-central1-a"
+	size = 10
+	disk_encryption_key {
+		kms_key_self_link = "${google_kms_crypto_key.foobar.self_link}"
+	}
+	depends_on = ["google_kms_crypto_key_iam_member.compute"]
+}
+
+resource "google_compute_instance" "foobar" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		source = "${google_compute_disk.boot.name}"
+	}
+
+	attached_disk {
+		source = "${google_compute_disk.foobar.self_link}"
+	}
+
+	network_interface {
+		network = "default"
+	}
+}
+`, testAccComputeKmsKey(keyRing), bootDisk, disk, instance)
+}
+
 func testAccComputeInstance_bootDisk_sourceUrl(disk, instance string) string {
 	return fmt.Sprintf(`
 resource "google_compute_disk" "foobar" {
@@ -2416,6 +2544,7 @@ resource "google_project" "host_project" {
 	project_id = "%s-host"
 	org_id = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "host_project" {
@@ -2432,6 +2561,7 @@ resource "google_project" "service_project" {
 	project_id = "%s-service"
 	org_id = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "service_project" {
@@ -2666,6 +2796,101 @@ resource "google_compute_instance" "foobar" {
 }`, instance)
 }
 
+func testAccComputeInstance_reservationAffinities(instance, reservation string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance" "foobar" {
+  name = "%s"
+  machine_type = "n1-standard-1"
+  zone = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "debian-cloud/debian-9"
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+
+  reservation_affinity {
+    type = "SPECIFIC_RESERVATION"
+
+    specific_reservation {
+      key = "compute.googleapis.com/reservation-name"
+      values = ["${google_compute_reservation.reservation.name}"]
+    }
+  }
+}
+
+resource "google_compute_reservation" "reservation" {
+  name = "%s"
+  zone = "us-central1-a"
+
+  specific_reservation_required = true
+
+  specific_reservation {
+    count = 1
+    instance_properties {
+      machine_type = "n1-standard-1"
+    }
+  }
+}
+`, instance, reservation)
+}
+
+func testAccComputeInstance_soleTenantNodeAffinities(instance, nodeTemplate, nodeGroup string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance" "foobar" {
+  name = "%s"
+  machine_type = "n1-standard-8"
+  zone = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "debian-cloud/debian-9"
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+
+  scheduling {
+    node_affinities {
+      key = "tfacc"
+      operator = "IN"
+      values = ["test"]
+    }
+
+    node_affinities {
+      key = "compute.googleapis.com/node-group-name"
+      operator = "IN"
+      values = ["${google_compute_node_group.nodes.name}"]
+    }
+  }
+}
+
+resource "google_compute_node_template" "nodetmpl" {
+  name = "%s"
+  region = "us-central1"
+  node_type = "n1-node-96-624"
+
+  node_affinity_labels {
+    tfacc = "test"
+  }
+}
+
+resource "google_compute_node_group" "nodes" {
+  name = "%s"
+  zone = "us-central1-a"
+
+  size = 1
+  node_template = "${google_compute_node_template.nodetmpl.self_link}"
+}
+`, instance, nodeTemplate, nodeGroup)
+}
+
 func testAccComputeInstance_primaryAliasIpRange(instance string) string {
 	return fmt.Sprintf(`
 resource "google_compute_instance" "foobar" {
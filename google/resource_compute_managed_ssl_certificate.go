@@ -0,0 +1,420 @@
+// ----------------------------------------------------------------------------
+//
+//     ***     AUTO GENERATED CODE    ***    AUTO GENERATED CODE     ***
+//
+// ----------------------------------------------------------------------------
+//
+//     This file is automatically generated by Magic Modules and manual
+//     changes will be clobbered when the file is regenerated.
+//
+//     Please read more about how to change this file in
+//     .github/CONTRIBUTING.md.
+//
+// ----------------------------------------------------------------------------
+
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	compute "google.golang.org/api/compute/v1"
+)
+
+func resourceComputeManagedSslCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeManagedSslCertificateCreate,
+		Read:   resourceComputeManagedSslCertificateRead,
+		Update: resourceComputeManagedSslCertificateUpdate,
+		Delete: resourceComputeManagedSslCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeManagedSslCertificateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3600 * time.Second),
+			Update: schema.DefaultTimeout(3600 * time.Second),
+			Delete: schema.DefaultTimeout(360 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^[a-z]([-a-z0-9]*[a-z0-9])?$`),
+			},
+			"managed": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domains": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_status": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"MANAGED", ""}, false),
+				Default:      "MANAGED",
+			},
+			"certificate_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeManagedSslCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputeManagedSslCertificateName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	managedProp, err := expandComputeManagedSslCertificateManaged(d.Get("managed"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("managed"); !isEmptyValue(reflect.ValueOf(managedProp)) && (ok || !reflect.DeepEqual(v, managedProp)) {
+		obj["managed"] = managedProp
+	}
+	descriptionProp, err := expandComputeManagedSslCertificateDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	typeProp, err := expandComputeManagedSslCertificateType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/sslCertificates")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ManagedSslCertificate: %#v", obj)
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error creating ManagedSslCertificate: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config.clientCompute, op, project, "Creating ManagedSslCertificate",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		// The resource didn't actually create
+		d.SetId("")
+		return fmt.Errorf("Error waiting to create ManagedSslCertificate: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating ManagedSslCertificate %q: %#v", d.Id(), res)
+
+	if d.Get("wait_for_active").(bool) {
+		if err := waitForManagedSslCertificateActive(d, config, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceComputeManagedSslCertificateRead(d, meta)
+}
+
+func resourceComputeManagedSslCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/sslCertificates/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeManagedSslCertificate %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenComputeManagedSslCertificateName(res["name"])); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("managed", flattenComputeManagedSslCertificateManaged(res["managed"])); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("description", flattenComputeManagedSslCertificateDescription(res["description"])); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("type", flattenComputeManagedSslCertificateType(res["type"])); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("certificate_id", flattenComputeManagedSslCertificateCertificateId(res["id"])); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("creation_timestamp", flattenComputeManagedSslCertificateCreationTimestamp(res["creationTimestamp"])); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("expire_time", flattenComputeManagedSslCertificateExpireTime(res["expireTime"])); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("subject_alternative_names", flattenComputeManagedSslCertificateSubjectAlternativeNames(res["subjectAlternativeNames"])); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading ManagedSslCertificate: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeManagedSslCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only wait_for_active can be updated, and it only affects Terraform.
+	if d.HasChange("wait_for_active") && d.Get("wait_for_active").(bool) {
+		config := meta.(*Config)
+		if err := waitForManagedSslCertificateActive(d, config, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceComputeManagedSslCertificateRead(d, meta)
+}
+
+func resourceComputeManagedSslCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/global/sslCertificates/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting ManagedSslCertificate %q", d.Id())
+	res, err := sendRequest(config, "DELETE", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, "ManagedSslCertificate")
+	}
+
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config.clientCompute, op, project, "Deleting ManagedSslCertificate",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting ManagedSslCertificate %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeManagedSslCertificateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	parseImportId([]string{"projects/(?P<project>[^/]+)/global/sslCertificates/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config)
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// wait_for_active isn't part of the API resource, so set its default.
+	d.Set("wait_for_active", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeManagedSslCertificateName(v interface{}) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateManaged(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+	transformed["domains"] =
+		flattenComputeManagedSslCertificateManagedDomains(original["domains"])
+	transformed["status"] =
+		flattenComputeManagedSslCertificateManagedStatus(original["status"])
+	transformed["domain_status"] =
+		flattenComputeManagedSslCertificateManagedDomainStatus(original["domainStatus"])
+	return []interface{}{transformed}
+}
+func flattenComputeManagedSslCertificateManagedDomains(v interface{}) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateManagedStatus(v interface{}) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateManagedDomainStatus(v interface{}) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateDescription(v interface{}) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateType(v interface{}) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateCertificateId(v interface{}) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeManagedSslCertificateCreationTimestamp(v interface{}) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateExpireTime(v interface{}) interface{} {
+	return v
+}
+
+func flattenComputeManagedSslCertificateSubjectAlternativeNames(v interface{}) interface{} {
+	return v
+}
+
+func expandComputeManagedSslCertificateName(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeManagedSslCertificateManaged(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedDomains, err := expandComputeManagedSslCertificateManagedDomains(original["domains"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	transformed["domains"] = transformedDomains
+	return transformed, nil
+}
+
+func expandComputeManagedSslCertificateManagedDomains(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeManagedSslCertificateDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeManagedSslCertificateType(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,149 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeManagedSslCertificate_targetHttpsProxy(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeManagedSslCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeManagedSslCertificate_targetHttpsProxy(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_managed_ssl_certificate.foobar", "managed.0.status", "PROVISIONING"),
+					resource.TestCheckResourceAttrSet("google_compute_managed_ssl_certificate.foobar", "certificate_id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_managed_ssl_certificate.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeManagedSslCertificate_targetSslProxy(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeManagedSslCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeManagedSslCertificate_targetSslProxy(suffix),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_managed_ssl_certificate.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeManagedSslCertificateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_managed_ssl_certificate" {
+			continue
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		name := rs.Primary.Attributes["name"]
+
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/global/sslCertificates/%s", project, name)
+		if _, err := sendRequest(config, "GET", url, nil); err == nil {
+			return fmt.Errorf("Error, Managed SSL Certificate %s still exists", name)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeManagedSslCertificate_targetHttpsProxy(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_managed_ssl_certificate" "foobar" {
+	name = "managed-cert-test-%s"
+	description = "Resource created for Terraform acceptance testing"
+	managed {
+		domains = ["sslcert.tf-test.club."]
+	}
+}
+
+resource "google_compute_target_https_proxy" "foobar" {
+	name = "managed-cert-test-%s"
+	url_map = "${google_compute_url_map.foobar.self_link}"
+	ssl_certificates = ["${google_compute_managed_ssl_certificate.foobar.self_link}"]
+}
+
+resource "google_compute_url_map" "foobar" {
+	name = "managed-cert-test-%s"
+	default_service = "${google_compute_backend_service.foobar.self_link}"
+}
+
+resource "google_compute_backend_service" "foobar" {
+	name = "managed-cert-test-%s"
+	health_checks = ["${google_compute_http_health_check.zero.self_link}"]
+}
+
+resource "google_compute_http_health_check" "zero" {
+	name = "managed-cert-test-%s"
+	request_path = "/"
+	check_interval_sec = 1
+	timeout_sec = 1
+}
+`, suffix, suffix, suffix, suffix, suffix)
+}
+
+func testAccComputeManagedSslCertificate_targetSslProxy(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_managed_ssl_certificate" "foobar" {
+	name = "managed-cert-test-%s"
+	managed {
+		domains = ["sslcert.tf-test.club."]
+	}
+}
+
+resource "google_compute_target_ssl_proxy" "foobar" {
+	name = "managed-cert-test-%s"
+	backend_service = "${google_compute_backend_service.foobar.self_link}"
+	ssl_certificates = ["${google_compute_managed_ssl_certificate.foobar.self_link}"]
+}
+
+resource "google_compute_backend_service" "foobar" {
+	name = "managed-cert-test-%s"
+	protocol = "SSL"
+	health_checks = ["${google_compute_health_check.zero.self_link}"]
+}
+
+resource "google_compute_health_check" "zero" {
+	name = "managed-cert-test-%s"
+	check_interval_sec = 1
+	timeout_sec = 1
+	tcp_health_check {
+		port = "443"
+	}
+}
+`, suffix, suffix, suffix, suffix)
+}
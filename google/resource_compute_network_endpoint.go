@@ -0,0 +1,238 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+)
+
+func resourceComputeNetworkEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeNetworkEndpointCreate,
+		Read:   resourceComputeNetworkEndpointRead,
+		Delete: resourceComputeNetworkEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeNetworkEndpointImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_endpoint_group": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"instance": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeNetworkEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	neg := GetResourceNameFromSelfLink(d.Get("network_endpoint_group").(string))
+	endpoint := expandNetworkEndpoint(d)
+
+	// Endpoints of a network endpoint group can only be attached or detached
+	// one operation at a time.
+	lockName := getNetworkEndpointGroupLockName(project, zone, neg)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	log.Printf("[DEBUG] Attaching network endpoint %#v to network endpoint group %q", endpoint, neg)
+	op, err := config.clientComputeBeta.NetworkEndpointGroups.AttachNetworkEndpoints(project, zone, neg,
+		&computeBeta.NetworkEndpointGroupsAttachEndpointsRequest{
+			NetworkEndpoints: []*computeBeta.NetworkEndpoint{endpoint},
+		}).Do()
+	if err != nil {
+		return fmt.Errorf("Error attaching network endpoint to network endpoint group %q: %s", neg, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s/%d", project, zone, neg, endpoint.Instance, endpoint.IpAddress, endpoint.Port))
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutCreate).Minutes()), "Attaching Network Endpoint")
+	if err != nil {
+		// The endpoint didn't actually attach
+		d.SetId("")
+		return err
+	}
+
+	return resourceComputeNetworkEndpointRead(d, meta)
+}
+
+func resourceComputeNetworkEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	neg := GetResourceNameFromSelfLink(d.Get("network_endpoint_group").(string))
+	want := expandNetworkEndpoint(d)
+
+	var found *computeBeta.NetworkEndpoint
+	err = config.clientComputeBeta.NetworkEndpointGroups.ListNetworkEndpoints(project, zone, neg,
+		&computeBeta.NetworkEndpointGroupsListEndpointsRequest{}).Pages(context.Background(),
+		func(resp *computeBeta.NetworkEndpointGroupsListNetworkEndpoints) error {
+			for _, item := range resp.Items {
+				if e := item.NetworkEndpoint; e != nil && networkEndpointEquals(e, want) {
+					found = e
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Network Endpoint Group %q", neg))
+	}
+
+	if found == nil {
+		log.Printf("[WARN] Removing network endpoint %s because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance", GetResourceNameFromSelfLink(found.Instance))
+	d.Set("ip_address", found.IpAddress)
+	d.Set("port", found.Port)
+	d.Set("zone", zone)
+	d.Set("project", project)
+
+	return nil
+}
+
+func resourceComputeNetworkEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	neg := GetResourceNameFromSelfLink(d.Get("network_endpoint_group").(string))
+	endpoint := expandNetworkEndpoint(d)
+
+	lockName := getNetworkEndpointGroupLockName(project, zone, neg)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	log.Printf("[DEBUG] Detaching network endpoint %s", d.Id())
+	op, err := config.clientComputeBeta.NetworkEndpointGroups.DetachNetworkEndpoints(project, zone, neg,
+		&computeBeta.NetworkEndpointGroupsDetachEndpointsRequest{
+			NetworkEndpoints: []*computeBeta.NetworkEndpoint{endpoint},
+		}).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Network Endpoint %q", d.Id()))
+	}
+
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, int(d.Timeout(schema.TimeoutDelete).Minutes()), "Detaching Network Endpoint")
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeNetworkEndpointImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/networkEndpointGroups/(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]+)/(?P<ip_address>[^/]+)/[0-9]+",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]+)/(?P<ip_address>[^/]+)/[0-9]+",
+		"(?P<zone>[^/]+)/(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]+)/(?P<ip_address>[^/]+)/[0-9]+",
+		"(?P<network_endpoint_group>[^/]+)/(?P<instance>[^/]+)/(?P<ip_address>[^/]+)/[0-9]+",
+	}, d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// The port is an int, so it's set outside of parseImportId. Every format
+	// ends with it.
+	parts := strings.Split(d.Id(), "/")
+	port, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid port in import id %q: %s", d.Id(), err)
+	}
+	d.Set("port", port)
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s/%d", d.Get("project"), d.Get("zone"), d.Get("network_endpoint_group"), d.Get("instance"), d.Get("ip_address"), port))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandNetworkEndpoint(d *schema.ResourceData) *computeBeta.NetworkEndpoint {
+	return &computeBeta.NetworkEndpoint{
+		Instance:  GetResourceNameFromSelfLink(d.Get("instance").(string)),
+		IpAddress: d.Get("ip_address").(string),
+		Port:      int64(d.Get("port").(int)),
+	}
+}
+
+func networkEndpointEquals(a, b *computeBeta.NetworkEndpoint) bool {
+	return GetResourceNameFromSelfLink(a.Instance) == GetResourceNameFromSelfLink(b.Instance) &&
+		a.IpAddress == b.IpAddress && a.Port == b.Port
+}
+
+func getNetworkEndpointGroupLockName(project, zone, neg string) string {
+	return fmt.Sprintf("networkEndpointGroup/%s/%s/%s", project, zone, neg)
+}
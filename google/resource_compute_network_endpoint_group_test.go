@@ -0,0 +1,83 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeNetworkEndpointGroup_basic(t *testing.T) {
+	t.Parallel()
+
+	negName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNetworkEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNetworkEndpointGroup_basic(negName, networkName),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_network_endpoint_group.neg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeNetworkEndpointGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_network_endpoint_group" {
+			continue
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		zone := rs.Primary.Attributes["zone"]
+		name := rs.Primary.Attributes["name"]
+
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/networkEndpointGroups/%s", project, zone, name)
+		if _, err := sendRequest(config, "GET", url, nil); err == nil {
+			return fmt.Errorf("Error, Network Endpoint Group %s in zone %s still exists", name, zone)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeNetworkEndpointGroup_basic(negName, networkName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network_endpoint_group" "neg" {
+  name         = "%s"
+  network      = "${google_compute_network.default.self_link}"
+  subnetwork   = "${google_compute_subnetwork.default.self_link}"
+  default_port = "90"
+  zone         = "us-central1-a"
+  description  = "example network endpoint group"
+}
+
+resource "google_compute_network" "default" {
+  name                    = "%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "%s"
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.self_link}"
+}
+`, negName, networkName, networkName)
+}
@@ -0,0 +1,115 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	computeBeta "google.golang.org/api/compute/v0.beta"
+)
+
+func TestAccComputeNetworkEndpoint_basic(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNetworkEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNetworkEndpoint_basic(suffix, 90),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_network_endpoint.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				// Changing the port detaches the old endpoint and attaches a new one.
+				Config: testAccComputeNetworkEndpoint_basic(suffix, 100),
+				Check:  testAccCheckComputeNetworkEndpointCount("google_compute_network_endpoint_group.neg", 1),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_network_endpoint.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeNetworkEndpointCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		res, err := config.clientComputeBeta.NetworkEndpointGroups.ListNetworkEndpoints(
+			config.Project, rs.Primary.Attributes["zone"], rs.Primary.Attributes["name"],
+			&computeBeta.NetworkEndpointGroupsListEndpointsRequest{}).Do()
+		if err != nil {
+			return err
+		}
+
+		if len(res.Items) != count {
+			return fmt.Errorf("Expected %d network endpoints, got %d", count, len(res.Items))
+		}
+		return nil
+	}
+}
+
+func testAccComputeNetworkEndpoint_basic(suffix string, port int) string {
+	return fmt.Sprintf(`
+resource "google_compute_network_endpoint" "default" {
+  network_endpoint_group = "${google_compute_network_endpoint_group.neg.name}"
+  zone                   = "us-central1-a"
+
+  instance   = "${google_compute_instance.default.name}"
+  port       = %d
+  ip_address = "${google_compute_instance.default.network_interface.0.network_ip}"
+}
+
+resource "google_compute_network_endpoint_group" "neg" {
+  name         = "neg-%s"
+  network      = "${google_compute_network.default.self_link}"
+  subnetwork   = "${google_compute_subnetwork.default.self_link}"
+  default_port = "90"
+  zone         = "us-central1-a"
+}
+
+resource "google_compute_network" "default" {
+  name                    = "neg-network-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "neg-subnetwork-%s"
+  ip_cidr_range = "10.0.0.0/16"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.self_link}"
+}
+
+resource "google_compute_instance" "default" {
+  name         = "neg-instance-%s"
+  machine_type = "n1-standard-1"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "debian-cloud/debian-9"
+    }
+  }
+
+  network_interface {
+    subnetwork = "${google_compute_subnetwork.default.self_link}"
+    access_config {}
+  }
+}
+`, port, suffix, suffix, suffix, suffix)
+}
@@ -0,0 +1,218 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeNodeGroup_update(t *testing.T) {
+	t.Parallel()
+
+	groupName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	tmplPrefix := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_update(groupName, tmplPrefix, "tmpl1"),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_group.nodes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_update(groupName, tmplPrefix, "tmpl2"),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_group.nodes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeNodeGroup_resize(t *testing.T) {
+	t.Parallel()
+
+	groupName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	tmplName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	var creationTimestamp string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_resize(groupName, tmplName, 1),
+				Check:  testAccCheckComputeNodeGroupCreationTimestamp("google_compute_node_group.nodes", &creationTimestamp),
+			},
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_resize(groupName, tmplName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_node_group.nodes", "size", "2"),
+					testAccCheckComputeNodeGroupCreationTimestamp("google_compute_node_group.nodes", &creationTimestamp),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_resize(groupName, tmplName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_node_group.nodes", "size", "1"),
+					testAccCheckComputeNodeGroupCreationTimestamp("google_compute_node_group.nodes", &creationTimestamp),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckComputeNodeGroupCreationTimestamp checks that the node group
+// n wasn't recreated since the first check.
+func testAccCheckComputeNodeGroupCreationTimestamp(n string, creationTimestamp *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		got := rs.Primary.Attributes["creation_timestamp"]
+		if *creationTimestamp == "" {
+			*creationTimestamp = got
+		} else if got != *creationTimestamp {
+			return fmt.Errorf("Node group %s was recreated, creation_timestamp changed from %s to %s", n, *creationTimestamp, got)
+		}
+		return nil
+	}
+}
+
+func TestAccComputeNodeGroup_autoscaling(t *testing.T) {
+	t.Parallel()
+
+	groupName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	tmplName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_autoscaling(groupName, tmplName, 2),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_group.nodes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeNodeGroup_autoscaling(groupName, tmplName, 3),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_group.nodes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeNodeGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_node_group" {
+			continue
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		zone := rs.Primary.Attributes["zone"]
+		name := rs.Primary.Attributes["name"]
+
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/nodeGroups/%s", project, zone, name)
+		if _, err := sendRequest(config, "GET", url, nil); err == nil {
+			return fmt.Errorf("Error, Node Group %s in zone %s still exists", name, zone)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeNodeGroup_update(groupName, tmplPrefix, tmplToUse string) string {
+	return fmt.Sprintf(`
+resource "google_compute_node_template" "tmpl1" {
+	name = "%s-first"
+	region = "us-central1"
+	node_type = "n1-node-96-624"
+}
+
+resource "google_compute_node_template" "tmpl2" {
+	name = "%s-second"
+	region = "us-central1"
+	node_type = "n1-node-96-624"
+}
+
+resource "google_compute_node_group" "nodes" {
+	name = "%s"
+	zone = "us-central1-a"
+	description = "example google_compute_node_group for Terraform Google Provider"
+
+	size = 1
+	node_template = "${google_compute_node_template.%s.self_link}"
+}
+`, tmplPrefix, tmplPrefix, groupName, tmplToUse)
+}
+
+func testAccComputeNodeGroup_resize(groupName, tmplName string, size int) string {
+	return fmt.Sprintf(`
+resource "google_compute_node_template" "tmpl" {
+	name = "%s"
+	region = "us-central1"
+	node_type = "n1-node-96-624"
+}
+
+resource "google_compute_node_group" "nodes" {
+	name = "%s"
+	zone = "us-central1-a"
+
+	size = %d
+	node_template = "${google_compute_node_template.tmpl.self_link}"
+}
+`, tmplName, groupName, size)
+}
+
+func testAccComputeNodeGroup_autoscaling(groupName, tmplName string, maxNodes int) string {
+	return fmt.Sprintf(`
+resource "google_compute_node_template" "tmpl" {
+	name = "%s"
+	region = "us-central1"
+	node_type = "n1-node-96-624"
+}
+
+resource "google_compute_node_group" "nodes" {
+	name = "%s"
+	zone = "us-central1-a"
+
+	size = 1
+	node_template = "${google_compute_node_template.tmpl.self_link}"
+	maintenance_policy = "RESTART_IN_PLACE"
+
+	autoscaling_policy {
+		mode = "ONLY_SCALE_OUT"
+		min_nodes = 1
+		max_nodes = %d
+	}
+}
+`, tmplName, groupName, maxNodes)
+}
@@ -0,0 +1,115 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeNodeTemplate_basic(t *testing.T) {
+	t.Parallel()
+
+	templateName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNodeTemplate_basic(templateName),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_template.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeNodeTemplate_nodeTypeFlexibility(t *testing.T) {
+	t.Parallel()
+
+	templateName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeNodeTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeNodeTemplate_nodeTypeFlexibility(templateName),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_node_template.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeNodeTemplateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_node_template" {
+			continue
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		region, err := getTestRegion(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		name := rs.Primary.Attributes["name"]
+
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/regions/%s/nodeTemplates/%s", project, region, name)
+		if _, err := sendRequest(config, "GET", url, nil); err == nil {
+			return fmt.Errorf("Error, Node Template %s in region %s still exists", name, region)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeNodeTemplate_basic(templateName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_node_template" "foo" {
+	name = "%s"
+	region = "us-central1"
+	node_type = "n1-node-96-624"
+
+	node_affinity_labels {
+		foo = "baz"
+	}
+
+	server_binding {
+		type = "RESTART_NODE_ON_MINIMAL_SERVERS"
+	}
+}
+`, templateName)
+}
+
+func testAccComputeNodeTemplate_nodeTypeFlexibility(templateName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_node_template" "foo" {
+	name = "%s"
+	region = "us-central1"
+
+	node_type_flexibility {
+		cpus = "96"
+		memory = "any"
+	}
+}
+`, templateName)
+}
@@ -0,0 +1,615 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputePerInstanceConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputePerInstanceConfigCreate,
+		Read:   resourceComputePerInstanceConfigRead,
+		Update: resourceComputePerInstanceConfigUpdate,
+		Delete: resourceComputePerInstanceConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputePerInstanceConfigImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_group_manager": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"preserved_state": schemaPerInstanceConfigPreservedState(),
+			"minimal_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "REFRESH", "RESTART", "REPLACE"}, false),
+			},
+			"most_disruptive_allowed_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "REPLACE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "REFRESH", "RESTART", "REPLACE"}, false),
+			},
+			"wait_until_applied": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputePerInstanceConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	name := d.Get("name").(string)
+
+	lockName := getInstanceGroupManagerLockName(project, zone, igm)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	if err := updatePerInstanceConfig(d, config, project, perInstanceConfigsUrl(project, zone, igm), name, schema.TimeoutCreate); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", project, zone, igm, name))
+
+	return resourceComputePerInstanceConfigRead(d, meta)
+}
+
+func resourceComputePerInstanceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	name := d.Get("name").(string)
+
+	perInstanceConfig, err := getPerInstanceConfig(config, perInstanceConfigsUrl(project, zone, igm), name)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Instance group manager %q", igm))
+	}
+	if perInstanceConfig == nil {
+		log.Printf("[WARN] Removing per-instance config %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("preserved_state", flattenPerInstanceConfigPreservedState(perInstanceConfig["preservedState"])); err != nil {
+		return fmt.Errorf("Error reading per-instance config: %s", err)
+	}
+	d.Set("status", perInstanceConfig["status"])
+	d.Set("project", project)
+	d.Set("zone", zone)
+
+	return nil
+}
+
+func resourceComputePerInstanceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	name := d.Get("name").(string)
+
+	lockName := getInstanceGroupManagerLockName(project, zone, igm)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	if d.HasChange("preserved_state") {
+		if err := updatePerInstanceConfig(d, config, project, perInstanceConfigsUrl(project, zone, igm), name, schema.TimeoutUpdate); err != nil {
+			return err
+		}
+	}
+
+	return resourceComputePerInstanceConfigRead(d, meta)
+}
+
+func resourceComputePerInstanceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	name := d.Get("name").(string)
+
+	lockName := getInstanceGroupManagerLockName(project, zone, igm)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	if err := deletePerInstanceConfig(d, config, project, perInstanceConfigsUrl(project, zone, igm), name); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputePerInstanceConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("Invalid per-instance config specifier. Expecting {project}/{zone}/{instance_group_manager}/{name}")
+	}
+
+	d.Set("project", parts[0])
+	d.Set("zone", parts[1])
+	d.Set("instance_group_manager", parts[2])
+	d.Set("name", parts[3])
+	d.Set("minimal_action", "NONE")
+	d.Set("most_disruptive_allowed_action", "REPLACE")
+	d.Set("wait_until_applied", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func schemaPerInstanceConfigPreservedState() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metadata": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"disk": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Set:      perInstanceConfigDiskHash,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"device_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"source": {
+								Type:             schema.TypeString,
+								Required:         true,
+								DiffSuppressFunc: compareSelfLinkRelativePaths,
+							},
+							"mode": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "READ_WRITE",
+								ValidateFunc: validation.StringInSlice([]string{"READ_WRITE", "READ_ONLY"}, false),
+							},
+							"delete_rule": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "NEVER",
+								ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+							},
+						},
+					},
+				},
+				"internal_ip": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Set:      perInstanceConfigInternalIpHash,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"interface_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"ip_address": {
+								Type:             schema.TypeString,
+								Required:         true,
+								DiffSuppressFunc: compareSelfLinkRelativePaths,
+							},
+							"delete_rule": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "NEVER",
+								ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Disks and internal IPs are hashed by the relative path of their source and
+// address, as they're read back as v1 self links.
+func perInstanceConfigDiskHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", m["device_name"]))
+	buf.WriteString(fmt.Sprintf("%s-", perInstanceConfigRelativePath(m["source"].(string))))
+	buf.WriteString(fmt.Sprintf("%v-", m["mode"]))
+	buf.WriteString(fmt.Sprintf("%v-", m["delete_rule"]))
+
+	return hashcode.String(buf.String())
+}
+
+func perInstanceConfigInternalIpHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", m["interface_name"]))
+	buf.WriteString(fmt.Sprintf("%s-", perInstanceConfigRelativePath(m["ip_address"].(string))))
+	buf.WriteString(fmt.Sprintf("%v-", m["delete_rule"]))
+
+	return hashcode.String(buf.String())
+}
+
+// perInstanceConfigRelativePath returns the relative path of a self link, or
+// v itself when it isn't one, like an IP address literal.
+func perInstanceConfigRelativePath(v string) string {
+	if path, err := getRelativePath(v); err == nil {
+		return path
+	}
+	return v
+}
+
+func getInstanceGroupManagerLockName(project, zone, igm string) string {
+	return fmt.Sprintf("instanceGroupManager/%s/%s/%s", project, zone, igm)
+}
+
+func perInstanceConfigsUrl(project, zone, igm string) string {
+	return fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/instanceGroupManagers/%s", project, zone, igm)
+}
+
+func regionPerInstanceConfigsUrl(project, region, igm string) string {
+	return fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/regions/%s/instanceGroupManagers/%s", project, region, igm)
+}
+
+// The functions below manage the per-instance configs of the zonal or regional
+// instance group manager at url.
+
+// updatePerInstanceConfig creates or replaces the per-instance config, then
+// applies it to the instance with minimal_action and waits for it to be
+// effective if wait_until_applied is set.
+func updatePerInstanceConfig(d *schema.ResourceData, config *Config, project, url, name, timeoutKey string) error {
+	o, n := d.GetChange("preserved_state")
+	perInstanceConfig := map[string]interface{}{
+		"name":           name,
+		"preservedState": expandPerInstanceConfigPreservedState(o.([]interface{}), n.([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating per-instance config of instance %q: %#v", name, perInstanceConfig)
+	res, err := sendRequest(config, "POST", url+"/updatePerInstanceConfigs", map[string]interface{}{
+		"perInstanceConfigs": []interface{}{perInstanceConfig},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating per-instance config of instance %q: %s", name, err)
+	}
+
+	if err := waitPerInstanceConfigOperation(d, config, res, project, "Updating per-instance config", timeoutKey); err != nil {
+		return err
+	}
+
+	if err := applyPerInstanceConfig(d, config, project, url, name, timeoutKey); err != nil {
+		return err
+	}
+
+	if !d.Get("wait_until_applied").(bool) {
+		return nil
+	}
+
+	return resource.Retry(d.Timeout(timeoutKey), func() *resource.RetryError {
+		perInstanceConfig, err := getPerInstanceConfig(config, url, name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if perInstanceConfig == nil {
+			return resource.NonRetryableError(fmt.Errorf("Per-instance config of instance %q not found", name))
+		}
+		if status := perInstanceConfig["status"]; status != "EFFECTIVE" {
+			return resource.RetryableError(fmt.Errorf("Per-instance config of instance %q isn't applied yet, status is %v", name, status))
+		}
+		return nil
+	})
+}
+
+// deletePerInstanceConfig deletes the per-instance config, then applies the
+// deletion to the instance with minimal_action.
+func deletePerInstanceConfig(d *schema.ResourceData, config *Config, project, url, name string) error {
+	log.Printf("[DEBUG] Deleting per-instance config %q", d.Id())
+	res, err := sendRequest(config, "POST", url+"/deletePerInstanceConfigs", map[string]interface{}{
+		"names": []string{name},
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Per-instance config %q", d.Id()))
+	}
+
+	if err := waitPerInstanceConfigOperation(d, config, res, project, "Deleting per-instance config", schema.TimeoutDelete); err != nil {
+		return err
+	}
+
+	// Removing the config only stops preserving the state, the instance keeps
+	// it until it's updated.
+	return applyPerInstanceConfig(d, config, project, url, name, schema.TimeoutDelete)
+}
+
+// applyPerInstanceConfig updates the instance with the current per-instance
+// config, unless minimal_action is NONE or the instance doesn't exist yet.
+func applyPerInstanceConfig(d *schema.ResourceData, config *Config, project, url, name, timeoutKey string) error {
+	minimalAction := d.Get("minimal_action").(string)
+	if minimalAction == "NONE" {
+		return nil
+	}
+
+	instance, err := findManagedInstance(config, url, name)
+	if err != nil {
+		return err
+	}
+	if instance == "" {
+		log.Printf("[DEBUG] Instance %q doesn't exist yet, it will be created with its per-instance config", name)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Applying per-instance config to instance %q with minimal action %s", name, minimalAction)
+	res, err := sendRequest(config, "POST", url+"/applyUpdatesToInstances", map[string]interface{}{
+		"instances":                   []string{instance},
+		"minimalAction":               minimalAction,
+		"mostDisruptiveAllowedAction": d.Get("most_disruptive_allowed_action").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("Error applying per-instance config to instance %q: %s", name, err)
+	}
+
+	return waitPerInstanceConfigOperation(d, config, res, project, "Applying per-instance config", timeoutKey)
+}
+
+func waitPerInstanceConfigOperation(d *schema.ResourceData, config *Config, res map[string]interface{}, project, activity, timeoutKey string) error {
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(config.clientCompute, op, project, activity, int(d.Timeout(timeoutKey).Minutes()))
+}
+
+// findManagedInstance returns the URL of the managed instance name, or an
+// empty string if the instance group manager has no such instance.
+func findManagedInstance(config *Config, url, name string) (string, error) {
+	instances, err := listManagedInstances(config, url)
+	if err != nil {
+		return "", err
+	}
+
+	for _, raw := range instances {
+		instance, _ := raw.(map[string]interface{})["instance"].(string)
+		if GetResourceNameFromSelfLink(instance) == name {
+			return instance, nil
+		}
+	}
+	return "", nil
+}
+
+// getPerInstanceConfig returns the per-instance config of the instance name,
+// or nil if the instance group manager has none.
+func getPerInstanceConfig(config *Config, url, name string) (map[string]interface{}, error) {
+	url += "/listPerInstanceConfigs"
+
+	pageToken := ""
+	for {
+		pageUrl := url
+		if pageToken != "" {
+			var err error
+			pageUrl, err = addQueryParams(url, map[string]string{"pageToken": pageToken})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := sendRequest(config, "POST", pageUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items, _ := res["items"].([]interface{})
+		for _, raw := range items {
+			item := raw.(map[string]interface{})
+			if item["name"] == name {
+				return item, nil
+			}
+		}
+
+		pageToken, _ = res["nextPageToken"].(string)
+		if pageToken == "" {
+			return nil, nil
+		}
+	}
+}
+
+// expandPerInstanceConfigPreservedState returns the preservedState of a
+// per-instance config changing from old to new. The API merges its maps with
+// the current config, so entries that were removed are sent as null to remove
+// them from the config.
+func expandPerInstanceConfigPreservedState(old, new []interface{}) map[string]interface{} {
+	preservedState := expandPerInstanceConfigPreservedStateMaps(new)
+	for field, removed := range expandPerInstanceConfigPreservedStateMaps(old) {
+		current := preservedState[field].(map[string]interface{})
+		for k := range removed.(map[string]interface{}) {
+			if _, ok := current[k]; !ok {
+				current[k] = nil
+			}
+		}
+	}
+	return preservedState
+}
+
+func expandPerInstanceConfigPreservedStateMaps(configured []interface{}) map[string]interface{} {
+	preservedState := map[string]interface{}{
+		"metadata":    map[string]interface{}{},
+		"disks":       map[string]interface{}{},
+		"internalIPs": map[string]interface{}{},
+	}
+	if len(configured) == 0 || configured[0] == nil {
+		return preservedState
+	}
+	data := configured[0].(map[string]interface{})
+
+	metadata := make(map[string]interface{})
+	for k, v := range data["metadata"].(map[string]interface{}) {
+		metadata[k] = v
+	}
+	preservedState["metadata"] = metadata
+
+	disks := make(map[string]interface{})
+	for _, raw := range data["disk"].(*schema.Set).List() {
+		disk := raw.(map[string]interface{})
+		disks[disk["device_name"].(string)] = map[string]interface{}{
+			"source":     disk["source"],
+			"mode":       disk["mode"],
+			"autoDelete": disk["delete_rule"],
+		}
+	}
+	preservedState["disks"] = disks
+
+	internalIPs := make(map[string]interface{})
+	for _, raw := range data["internal_ip"].(*schema.Set).List() {
+		ip := raw.(map[string]interface{})
+		internalIPs[ip["interface_name"].(string)] = map[string]interface{}{
+			"ipAddress":  expandPerInstanceConfigIpAddress(ip["ip_address"].(string)),
+			"autoDelete": ip["delete_rule"],
+		}
+	}
+	preservedState["internalIPs"] = internalIPs
+
+	return preservedState
+}
+
+// expandPerInstanceConfigIpAddress references either an address resource,
+// by self link, or an IP address literal.
+func expandPerInstanceConfigIpAddress(v string) map[string]interface{} {
+	if strings.Contains(v, "/") {
+		return map[string]interface{}{"address": v}
+	}
+	return map[string]interface{}{"literal": v}
+}
+
+func flattenPerInstanceConfigPreservedState(v interface{}) []map[string]interface{} {
+	preservedState, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	disks := make([]map[string]interface{}, 0)
+	if raw, ok := preservedState["disks"].(map[string]interface{}); ok {
+		for _, deviceName := range sortedMapKeys(raw) {
+			disk := raw[deviceName].(map[string]interface{})
+			disks = append(disks, map[string]interface{}{
+				"device_name": deviceName,
+				"source":      ConvertSelfLinkToV1(fmt.Sprint(disk["source"])),
+				"mode":        disk["mode"],
+				"delete_rule": disk["autoDelete"],
+			})
+		}
+	}
+
+	internalIPs := make([]map[string]interface{}, 0)
+	if raw, ok := preservedState["internalIPs"].(map[string]interface{}); ok {
+		for _, interfaceName := range sortedMapKeys(raw) {
+			ip := raw[interfaceName].(map[string]interface{})
+			ipAddress, _ := ip["ipAddress"].(map[string]interface{})
+			address, ok := ipAddress["address"].(string)
+			if ok {
+				address = ConvertSelfLinkToV1(address)
+			} else {
+				address, _ = ipAddress["literal"].(string)
+			}
+			internalIPs = append(internalIPs, map[string]interface{}{
+				"interface_name": interfaceName,
+				"ip_address":     address,
+				"delete_rule":    ip["autoDelete"],
+			})
+		}
+	}
+
+	if len(disks) == 0 && len(internalIPs) == 0 && preservedState["metadata"] == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"metadata":    preservedState["metadata"],
+			"disk":        disks,
+			"internal_ip": internalIPs,
+		},
+	}
+}
@@ -0,0 +1,279 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputePerInstanceConfig_basic(t *testing.T) {
+	t.Parallel()
+
+	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+	disk := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputePerInstanceConfigDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputePerInstanceConfig_basic(igm, disk, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_per_instance_config.default", "status", "EFFECTIVE"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "google_compute_per_instance_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"minimal_action", "wait_until_applied"},
+			},
+			resource.TestStep{
+				Config: testAccComputePerInstanceConfig_basic(igm, disk, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_per_instance_config.default", "preserved_state.0.metadata.foo", "baz"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "google_compute_per_instance_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"minimal_action", "wait_until_applied"},
+			},
+			resource.TestStep{
+				Config: testAccComputePerInstanceConfig_removeDisk(igm, disk),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_per_instance_config.default", "preserved_state.0.disk.#", "0"),
+					resource.TestCheckNoResourceAttr("google_compute_per_instance_config.default", "preserved_state.0.metadata.foo"),
+					resource.TestCheckResourceAttr("google_compute_per_instance_config.default", "preserved_state.0.metadata.bar", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandPerInstanceConfigPreservedState(t *testing.T) {
+	disk := func(deviceName string) map[string]interface{} {
+		return map[string]interface{}{
+			"device_name": deviceName,
+			"source":      "projects/p/zones/z/disks/" + deviceName,
+			"mode":        "READ_WRITE",
+			"delete_rule": "NEVER",
+		}
+	}
+	preservedState := func(metadata map[string]interface{}, disks ...interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"metadata":    metadata,
+				"disk":        schema.NewSet(perInstanceConfigDiskHash, disks),
+				"internal_ip": schema.NewSet(perInstanceConfigInternalIpHash, nil),
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		Old, New []interface{}
+		Expected map[string]interface{}
+	}{
+		"create": {
+			Old: nil,
+			New: preservedState(map[string]interface{}{"foo": "bar"}, disk("data")),
+			Expected: map[string]interface{}{
+				"metadata": map[string]interface{}{"foo": "bar"},
+				"disks": map[string]interface{}{
+					"data": map[string]interface{}{"source": "projects/p/zones/z/disks/data", "mode": "READ_WRITE", "autoDelete": "NEVER"},
+				},
+				"internalIPs": map[string]interface{}{},
+			},
+		},
+		"remove entries": {
+			Old: preservedState(map[string]interface{}{"foo": "bar", "baz": "qux"}, disk("data"), disk("logs")),
+			New: preservedState(map[string]interface{}{"baz": "quux"}, disk("logs")),
+			Expected: map[string]interface{}{
+				"metadata": map[string]interface{}{"foo": nil, "baz": "quux"},
+				"disks": map[string]interface{}{
+					"data": nil,
+					"logs": map[string]interface{}{"source": "projects/p/zones/z/disks/logs", "mode": "READ_WRITE", "autoDelete": "NEVER"},
+				},
+				"internalIPs": map[string]interface{}{},
+			},
+		},
+		"remove preserved state": {
+			Old: preservedState(map[string]interface{}{"foo": "bar"}, disk("data")),
+			New: nil,
+			Expected: map[string]interface{}{
+				"metadata":    map[string]interface{}{"foo": nil},
+				"disks":       map[string]interface{}{"data": nil},
+				"internalIPs": map[string]interface{}{},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := expandPerInstanceConfigPreservedState(tc.Old, tc.New); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %#v, got %#v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func testAccCheckComputePerInstanceConfigDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_per_instance_config" {
+			continue
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		zone := rs.Primary.Attributes["zone"]
+		igm := GetResourceNameFromSelfLink(rs.Primary.Attributes["instance_group_manager"])
+		name := rs.Primary.Attributes["name"]
+
+		perInstanceConfig, err := getPerInstanceConfig(config, perInstanceConfigsUrl(project, zone, igm), name)
+		if err == nil && perInstanceConfig != nil {
+			return fmt.Errorf("Per-instance config %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputePerInstanceConfig_basic(igm, disk, metadata string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_template" "default" {
+	name_prefix = "igm-test-"
+	machine_type = "n1-standard-1"
+	disk {
+		source_image = "debian-cloud/debian-9"
+		auto_delete = true
+		boot = true
+	}
+	network_interface {
+		network = "default"
+	}
+}
+
+resource "google_compute_instance_group_manager" "default" {
+	name = "%s"
+	instance_template = "${google_compute_instance_template.default.self_link}"
+	base_instance_name = "igm-test"
+	zone = "us-central1-c"
+	target_size = 0
+}
+
+resource "google_compute_disk" "default" {
+	name = "%s"
+	type = "pd-standard"
+	size = 10
+	zone = "us-central1-c"
+}
+
+resource "google_compute_per_instance_config" "default" {
+	instance_group_manager = "${google_compute_instance_group_manager.default.name}"
+	zone = "us-central1-c"
+	name = "instance-1"
+	preserved_state {
+		metadata {
+			foo = "%s"
+		}
+		disk {
+			device_name = "data"
+			source = "${google_compute_disk.default.self_link}"
+		}
+	}
+	minimal_action = "REPLACE"
+	wait_until_applied = true
+}
+`, igm, disk, metadata)
+}
+
+func testAccComputePerInstanceConfig_removeDisk(igm, disk string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_template" "default" {
+	name_prefix = "igm-test-"
+	machine_type = "n1-standard-1"
+	disk {
+		source_image = "debian-cloud/debian-9"
+		auto_delete = true
+		boot = true
+	}
+	network_interface {
+		network = "default"
+	}
+}
+
+resource "google_compute_instance_group_manager" "default" {
+	name = "%s"
+	instance_template = "${google_compute_instance_template.default.self_link}"
+	base_instance_name = "igm-test"
+	zone = "us-central1-c"
+	target_size = 0
+}
+
+resource "google_compute_disk" "default" {
+	name = "%s"
+	type = "pd-standard"
+	size = 10
+	zone = "us-central1-c"
+}
+
+resource "google_compute_per_instance_config" "default" {
+	instance_group_manager = "${google_compute_instance_group_manager.default.name}"
+	zone = "us-central1-c"
+	name = "instance-1"
+	preserved_state {
+		metadata {
+			bar = "baz"
+		}
+	}
+	minimal_action = "REPLACE"
+	wait_until_applied = true
+}
+`, igm, disk)
+}
+
+func TestPerInstanceConfigHashes(t *testing.T) {
+	disk := func(source string) map[string]interface{} {
+		return map[string]interface{}{
+			"device_name": "data",
+			"source":      source,
+			"mode":        "READ_WRITE",
+			"delete_rule": "NEVER",
+		}
+	}
+	ip := func(address string) map[string]interface{} {
+		return map[string]interface{}{
+			"interface_name": "nic0",
+			"ip_address":     address,
+			"delete_rule":    "NEVER",
+		}
+	}
+
+	v1Disk := perInstanceConfigDiskHash(disk("https://www.googleapis.com/compute/v1/projects/p/zones/z/disks/data"))
+	for _, source := range []string{
+		"projects/p/zones/z/disks/data",
+		"https://www.googleapis.com/compute/beta/projects/p/zones/z/disks/data",
+	} {
+		if h := perInstanceConfigDiskHash(disk(source)); h != v1Disk {
+			t.Errorf("disk with source %q hashed to %d, expected %d like its v1 self link", source, h, v1Disk)
+		}
+	}
+
+	v1Address := perInstanceConfigInternalIpHash(ip("https://www.googleapis.com/compute/v1/projects/p/regions/r/addresses/a"))
+	if h := perInstanceConfigInternalIpHash(ip("projects/p/regions/r/addresses/a")); h != v1Address {
+		t.Errorf("internal IP with a relative address hashed to %d, expected %d like its v1 self link", h, v1Address)
+	}
+	if perInstanceConfigInternalIpHash(ip("10.0.0.2")) == perInstanceConfigInternalIpHash(ip("10.0.0.3")) {
+		t.Errorf("internal IPs with different literals hashed the same")
+	}
+}
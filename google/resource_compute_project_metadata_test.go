@@ -210,6 +210,7 @@ resource "google_project" "project" {
   name = "%s"
   org_id = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "compute" {
@@ -234,6 +235,7 @@ resource "google_project" "project" {
   name = "%s"
   org_id = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "compute" {
@@ -258,6 +260,7 @@ resource "google_project" "project" {
   name = "%s"
   org_id = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "compute" {
@@ -283,6 +286,7 @@ resource "google_project" "project" {
   name = "%s"
   org_id = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "compute" {
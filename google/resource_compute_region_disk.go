@@ -74,6 +74,14 @@ func resourceComputeRegionDisk() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"resource_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+			},
 			"size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -105,6 +113,12 @@ func resourceComputeRegionDisk() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"kms_key_self_link": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareCryptoKeyVersions,
+						},
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -130,6 +144,12 @@ func resourceComputeRegionDisk() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"kms_key_self_link": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareCryptoKeyVersions,
+						},
 						"sha256": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -248,6 +268,12 @@ func resourceComputeRegionDiskCreate(d *schema.ResourceData, meta interface{}) e
 	} else if v, ok := d.GetOkExists("source_snapshot_encryption_key"); !isEmptyValue(reflect.ValueOf(sourceSnapshotEncryptionKeyProp)) && (ok || !reflect.DeepEqual(v, sourceSnapshotEncryptionKeyProp)) {
 		obj["sourceSnapshotEncryptionKey"] = sourceSnapshotEncryptionKeyProp
 	}
+	resourcePoliciesProp, err := expandComputeRegionDiskResourcePolicies(d.Get("resource_policies"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("resource_policies"); !isEmptyValue(reflect.ValueOf(resourcePoliciesProp)) && (ok || !reflect.DeepEqual(v, resourcePoliciesProp)) {
+		obj["resourcePolicies"] = resourcePoliciesProp
+	}
 
 	obj, err = resourceComputeRegionDiskEncoder(d, meta, obj)
 	if err != nil {
@@ -364,6 +390,9 @@ func resourceComputeRegionDiskRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("source_snapshot_id", flattenComputeRegionDiskSourceSnapshotId(res["sourceSnapshotId"])); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
+	if err := d.Set("resource_policies", flattenComputeRegionDiskResourcePolicies(res["resourcePolicies"])); err != nil {
+		return fmt.Errorf("Error reading RegionDisk: %s", err)
+	}
 	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
 		return fmt.Errorf("Error reading RegionDisk: %s", err)
 	}
@@ -457,6 +486,18 @@ func resourceComputeRegionDiskUpdate(d *schema.ResourceData, meta interface{}) e
 
 		d.SetPartial("size")
 	}
+	if d.HasChange("resource_policies") {
+		url, err = replaceVars(d, config, "https://www.googleapis.com/compute/beta/projects/{{project}}/regions/{{region}}/disks/{{name}}")
+		if err != nil {
+			return err
+		}
+		err = updateComputeResourcePolicies(d, config, project, url)
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("resource_policies")
+	}
 
 	d.Partial(false)
 
@@ -639,6 +680,8 @@ func flattenComputeRegionDiskDiskEncryptionKey(v interface{}) interface{} {
 		flattenComputeRegionDiskDiskEncryptionKeyRawKey(original["rawKey"])
 	transformed["sha256"] =
 		flattenComputeRegionDiskDiskEncryptionKeySha256(original["sha256"])
+	transformed["kms_key_self_link"] =
+		flattenComputeRegionDiskDiskEncryptionKeyKmsKeySelfLink(original["kmsKeyName"])
 	return []interface{}{transformed}
 }
 func flattenComputeRegionDiskDiskEncryptionKeyRawKey(v interface{}) interface{} {
@@ -649,6 +692,10 @@ func flattenComputeRegionDiskDiskEncryptionKeySha256(v interface{}) interface{}
 	return v
 }
 
+func flattenComputeRegionDiskDiskEncryptionKeyKmsKeySelfLink(v interface{}) interface{} {
+	return v
+}
+
 func flattenComputeRegionDiskSnapshot(v interface{}) interface{} {
 	if v == nil {
 		return v
@@ -666,6 +713,8 @@ func flattenComputeRegionDiskSourceSnapshotEncryptionKey(v interface{}) interfac
 		flattenComputeRegionDiskSourceSnapshotEncryptionKeyRawKey(original["rawKey"])
 	transformed["sha256"] =
 		flattenComputeRegionDiskSourceSnapshotEncryptionKeySha256(original["sha256"])
+	transformed["kms_key_self_link"] =
+		flattenComputeRegionDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(original["kmsKeyName"])
 	return []interface{}{transformed}
 }
 func flattenComputeRegionDiskSourceSnapshotEncryptionKeyRawKey(v interface{}) interface{} {
@@ -676,10 +725,21 @@ func flattenComputeRegionDiskSourceSnapshotEncryptionKeySha256(v interface{}) in
 	return v
 }
 
+func flattenComputeRegionDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(v interface{}) interface{} {
+	return v
+}
+
 func flattenComputeRegionDiskSourceSnapshotId(v interface{}) interface{} {
 	return v
 }
 
+func flattenComputeRegionDiskResourcePolicies(v interface{}) interface{} {
+	if v == nil {
+		return v
+	}
+	return convertAndMapStringArr(v.([]interface{}), ConvertSelfLinkToV1)
+}
+
 func expandComputeRegionDiskDescription(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -745,12 +805,21 @@ func expandComputeRegionDiskDiskEncryptionKey(v interface{}, d *schema.ResourceD
 	if err != nil {
 		return nil, err
 	}
-	transformed["rawKey"] = transformedRawKey
+	if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !isEmptyValue(val) {
+		transformed["rawKey"] = transformedRawKey
+	}
 	transformedSha256, err := expandComputeRegionDiskDiskEncryptionKeySha256(original["sha256"], d, config)
 	if err != nil {
 		return nil, err
 	}
 	transformed["sha256"] = transformedSha256
+	transformedKmsKeySelfLink, err := expandComputeRegionDiskDiskEncryptionKeyKmsKeySelfLink(original["kms_key_self_link"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	if val := reflect.ValueOf(transformedKmsKeySelfLink); val.IsValid() && !isEmptyValue(val) {
+		transformed["kmsKeyName"] = transformedKmsKeySelfLink
+	}
 	return transformed, nil
 }
 
@@ -762,6 +831,10 @@ func expandComputeRegionDiskDiskEncryptionKeySha256(v interface{}, d *schema.Res
 	return v, nil
 }
 
+func expandComputeRegionDiskDiskEncryptionKeyKmsKeySelfLink(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandComputeRegionDiskSnapshot(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
 	f, err := parseGlobalFieldValue("snapshots", v.(string), "project", d, config, true)
 	if err != nil {
@@ -783,12 +856,21 @@ func expandComputeRegionDiskSourceSnapshotEncryptionKey(v interface{}, d *schema
 	if err != nil {
 		return nil, err
 	}
-	transformed["rawKey"] = transformedRawKey
+	if val := reflect.ValueOf(transformedRawKey); val.IsValid() && !isEmptyValue(val) {
+		transformed["rawKey"] = transformedRawKey
+	}
 	transformedSha256, err := expandComputeRegionDiskSourceSnapshotEncryptionKeySha256(original["sha256"], d, config)
 	if err != nil {
 		return nil, err
 	}
 	transformed["sha256"] = transformedSha256
+	transformedKmsKeySelfLink, err := expandComputeRegionDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(original["kms_key_self_link"], d, config)
+	if err != nil {
+		return nil, err
+	}
+	if val := reflect.ValueOf(transformedKmsKeySelfLink); val.IsValid() && !isEmptyValue(val) {
+		transformed["kmsKeyName"] = transformedKmsKeySelfLink
+	}
 	return transformed, nil
 }
 
@@ -800,6 +882,14 @@ func expandComputeRegionDiskSourceSnapshotEncryptionKeySha256(v interface{}, d *
 	return v, nil
 }
 
+func expandComputeRegionDiskSourceSnapshotEncryptionKeyKmsKeySelfLink(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeRegionDiskResourcePolicies(v interface{}, d *schema.ResourceData, config *Config) (interface{}, error) {
+	return expandComputeResourcePolicies(v, d, config)
+}
+
 func resourceComputeRegionDiskEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
 	config := meta.(*Config)
 
@@ -871,6 +961,7 @@ func resourceComputeRegionDiskDecoder(d *schema.ResourceData, meta interface{},
 		// The raw key won't be returned, so we need to use the original.
 		transformed["rawKey"] = d.Get("disk_encryption_key.0.raw_key")
 		transformed["sha256"] = original["sha256"]
+		transformed["kmsKeyName"] = original["kmsKeyName"]
 		res["diskEncryptionKey"] = transformed
 	}
 
@@ -889,6 +980,7 @@ func resourceComputeRegionDiskDecoder(d *schema.ResourceData, meta interface{},
 		// The raw key won't be returned, so we need to use the original.
 		transformed["rawKey"] = d.Get("source_snapshot_encryption_key.0.raw_key")
 		transformed["sha256"] = original["sha256"]
+		transformed["kmsKeyName"] = original["kmsKeyName"]
 		res["sourceSnapshotEncryptionKey"] = transformed
 	}
 
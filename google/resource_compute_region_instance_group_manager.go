@@ -170,6 +170,13 @@ func resourceComputeRegionInstanceGroupManager() *schema.Resource {
 				Default:  false,
 			},
 
+			"wait_for_instances_status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "STABLE",
+				ValidateFunc: validation.StringInSlice([]string{"STABLE", "UPDATED"}, false),
+			},
+
 			"auto_healing_policies": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -203,6 +210,26 @@ func resourceComputeRegionInstanceGroupManager() *schema.Resource {
 				},
 			},
 
+			"stateful_disk": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"delete_rule": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "NEVER",
+							ValidateFunc: validation.StringInSlice([]string{"NEVER", "ON_PERMANENT_INSTANCE_DELETION"}, false),
+						},
+					},
+				},
+			},
+
 			"rolling_update_policy": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -273,6 +300,10 @@ func resourceComputeRegionInstanceGroupManagerCreate(d *schema.ResourceData, met
 		return fmt.Errorf("[rolling_update_policy] must be set when 'update_strategy' is set to 'ROLLING_UPDATE'")
 	}
 
+	if err := validateStatefulUpdatePolicy(d); err != nil {
+		return err
+	}
+
 	manager := &computeBeta.InstanceGroupManager{
 		Name:                d.Get("name").(string),
 		Description:         d.Get("description").(string),
@@ -288,7 +319,13 @@ func resourceComputeRegionInstanceGroupManagerCreate(d *schema.ResourceData, met
 		ForceSendFields: []string{"TargetSize"},
 	}
 
-	op, err := config.clientComputeBeta.RegionInstanceGroupManagers.Insert(project, d.Get("region").(string), manager).Do()
+	var op *computeBeta.Operation
+	if d.Get("stateful_disk").(*schema.Set).Len() > 0 {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/regions/%s/instanceGroupManagers", project, d.Get("region").(string))
+		op, err = insertInstanceGroupManagerWithStatefulPolicy(d, config, url, manager)
+	} else {
+		op, err = config.clientComputeBeta.RegionInstanceGroupManagers.Insert(project, d.Get("region").(string), manager).Do()
+	}
 
 	if err != nil {
 		return fmt.Errorf("Error creating RegionInstanceGroupManager: %s", err)
@@ -301,6 +338,11 @@ func resourceComputeRegionInstanceGroupManagerCreate(d *schema.ResourceData, met
 	if err != nil {
 		return err
 	}
+
+	if err := waitForInstancesUpdated(getRegionalManager, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	return resourceComputeRegionInstanceGroupManagerRead(d, config)
 }
 
@@ -379,15 +421,16 @@ func resourceComputeRegionInstanceGroupManagerRead(d *schema.ResourceData, meta
 	}
 	d.Set("update_strategy", update_strategy.(string))
 
-	if d.Get("wait_for_instances").(bool) {
-		conf := resource.StateChangeConf{
-			Pending: []string{"creating", "error"},
-			Target:  []string{"created"},
-			Refresh: waitForInstancesRefreshFunc(getRegionalManager, d, meta),
-			Timeout: d.Timeout(schema.TimeoutCreate),
-		}
-		_, err := conf.WaitForState()
-		if err != nil {
+	statefulDisks, err := readStatefulDisks(config, manager.SelfLink)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("stateful_disk", statefulDisks); err != nil {
+		return err
+	}
+
+	if d.Get("wait_for_instances").(bool) && d.Get("wait_for_instances_status").(string) == "STABLE" {
+		if err := waitForInstancesStable(getRegionalManager, d, meta); err != nil {
 			return err
 		}
 	}
@@ -468,6 +511,10 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 		return fmt.Errorf("[rolling_update_policy] must be set when 'update_strategy' is set to 'ROLLING_UPDATE'")
 	}
 
+	if err := validateStatefulUpdatePolicy(d); err != nil {
+		return err
+	}
+
 	if d.HasChange("target_pools") {
 		targetPools := convertStringSet(d.Get("target_pools").(*schema.Set))
 
@@ -493,6 +540,17 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 		d.SetPartial("target_pools")
 	}
 
+	// Update the preserved state before the instances are updated, so they
+	// keep it.
+	if d.HasChange("stateful_disk") {
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/regions/%s/instanceGroupManagers/%s", project, region, d.Id())
+		if err := patchStatefulPolicy(d, config, project, url); err != nil {
+			return err
+		}
+
+		d.SetPartial("stateful_disk")
+	}
+
 	if d.HasChange("instance_template") {
 		// Build the parameter
 		setInstanceTemplate := &computeBeta.RegionInstanceGroupManagersSetTemplateRequest{
@@ -597,6 +655,10 @@ func resourceComputeRegionInstanceGroupManagerUpdate(d *schema.ResourceData, met
 
 	d.Partial(false)
 
+	if err := waitForInstancesUpdated(getRegionalManager, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	return resourceComputeRegionInstanceGroupManagerRead(d, meta)
 }
 
@@ -661,5 +723,6 @@ func hashZoneFromSelfLinkOrResourceName(value interface{}) int {
 
 func resourceRegionInstanceGroupManagerStateImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("wait_for_instances", false)
+	d.Set("wait_for_instances_status", "STABLE")
 	return []*schema.ResourceData{d}, nil
 }
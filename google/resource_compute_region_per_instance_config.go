@@ -0,0 +1,216 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceComputeRegionPerInstanceConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeRegionPerInstanceConfigCreate,
+		Read:   resourceComputeRegionPerInstanceConfigRead,
+		Update: resourceComputeRegionPerInstanceConfigUpdate,
+		Delete: resourceComputeRegionPerInstanceConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeRegionPerInstanceConfigImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_group_manager": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"preserved_state": schemaPerInstanceConfigPreservedState(),
+			"minimal_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "REFRESH", "RESTART", "REPLACE"}, false),
+			},
+			"most_disruptive_allowed_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "REPLACE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "REFRESH", "RESTART", "REPLACE"}, false),
+			},
+			"wait_until_applied": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeRegionPerInstanceConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	name := d.Get("name").(string)
+
+	lockName := getInstanceGroupManagerLockName(project, region, igm)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	if err := updatePerInstanceConfig(d, config, project, regionPerInstanceConfigsUrl(project, region, igm), name, schema.TimeoutCreate); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", project, region, igm, name))
+
+	return resourceComputeRegionPerInstanceConfigRead(d, meta)
+}
+
+func resourceComputeRegionPerInstanceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	name := d.Get("name").(string)
+
+	perInstanceConfig, err := getPerInstanceConfig(config, regionPerInstanceConfigsUrl(project, region, igm), name)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Instance group manager %q", igm))
+	}
+	if perInstanceConfig == nil {
+		log.Printf("[WARN] Removing per-instance config %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("preserved_state", flattenPerInstanceConfigPreservedState(perInstanceConfig["preservedState"])); err != nil {
+		return fmt.Errorf("Error reading per-instance config: %s", err)
+	}
+	d.Set("status", perInstanceConfig["status"])
+	d.Set("project", project)
+	d.Set("region", region)
+
+	return nil
+}
+
+func resourceComputeRegionPerInstanceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	name := d.Get("name").(string)
+
+	lockName := getInstanceGroupManagerLockName(project, region, igm)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	if d.HasChange("preserved_state") {
+		if err := updatePerInstanceConfig(d, config, project, regionPerInstanceConfigsUrl(project, region, igm), name, schema.TimeoutUpdate); err != nil {
+			return err
+		}
+	}
+
+	return resourceComputeRegionPerInstanceConfigRead(d, meta)
+}
+
+func resourceComputeRegionPerInstanceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	igm := GetResourceNameFromSelfLink(d.Get("instance_group_manager").(string))
+	name := d.Get("name").(string)
+
+	lockName := getInstanceGroupManagerLockName(project, region, igm)
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	if err := deletePerInstanceConfig(d, config, project, regionPerInstanceConfigsUrl(project, region, igm), name); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeRegionPerInstanceConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("Invalid region per-instance config specifier. Expecting {project}/{region}/{instance_group_manager}/{name}")
+	}
+
+	d.Set("project", parts[0])
+	d.Set("region", parts[1])
+	d.Set("instance_group_manager", parts[2])
+	d.Set("name", parts[3])
+	d.Set("minimal_action", "NONE")
+	d.Set("most_disruptive_allowed_action", "REPLACE")
+	d.Set("wait_until_applied", false)
+
+	return []*schema.ResourceData{d}, nil
+}
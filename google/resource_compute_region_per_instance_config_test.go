@@ -0,0 +1,112 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeRegionPerInstanceConfig_basic(t *testing.T) {
+	t.Parallel()
+
+	igm := fmt.Sprintf("igm-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionPerInstanceConfigDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeRegionPerInstanceConfig_basic(igm, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_per_instance_config.default", "status", "EFFECTIVE"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "google_compute_region_per_instance_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"minimal_action", "wait_until_applied"},
+			},
+			resource.TestStep{
+				Config: testAccComputeRegionPerInstanceConfig_basic(igm, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_compute_region_per_instance_config.default", "preserved_state.0.metadata.foo", "baz"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "google_compute_region_per_instance_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"minimal_action", "wait_until_applied"},
+			},
+		},
+	})
+}
+
+func testAccCheckComputeRegionPerInstanceConfigDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_region_per_instance_config" {
+			continue
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		region := rs.Primary.Attributes["region"]
+		igm := GetResourceNameFromSelfLink(rs.Primary.Attributes["instance_group_manager"])
+		name := rs.Primary.Attributes["name"]
+
+		perInstanceConfig, err := getPerInstanceConfig(config, regionPerInstanceConfigsUrl(project, region, igm), name)
+		if err == nil && perInstanceConfig != nil {
+			return fmt.Errorf("Region per-instance config %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeRegionPerInstanceConfig_basic(igm, metadata string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_template" "default" {
+	name_prefix = "igm-test-"
+	machine_type = "n1-standard-1"
+	disk {
+		source_image = "debian-cloud/debian-9"
+		auto_delete = true
+		boot = true
+	}
+	network_interface {
+		network = "default"
+	}
+}
+
+resource "google_compute_region_instance_group_manager" "default" {
+	name = "%s"
+	instance_template = "${google_compute_instance_template.default.self_link}"
+	base_instance_name = "igm-test"
+	region = "us-central1"
+	target_size = 0
+}
+
+resource "google_compute_region_per_instance_config" "default" {
+	instance_group_manager = "${google_compute_region_instance_group_manager.default.name}"
+	region = "us-central1"
+	name = "instance-1"
+	preserved_state {
+		metadata {
+			foo = "%s"
+		}
+	}
+	minimal_action = "REPLACE"
+	wait_until_applied = true
+}
+`, igm, metadata)
+}
@@ -0,0 +1,131 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeReservation_update(t *testing.T) {
+	t.Parallel()
+
+	reservationName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeReservationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeReservation_basic(reservationName, 1),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				Config: testAccComputeReservation_basic(reservationName, 2),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeReservation_accelerators(t *testing.T) {
+	t.Parallel()
+
+	reservationName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeReservationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeReservation_accelerators(reservationName),
+			},
+			resource.TestStep{
+				ResourceName:      "google_compute_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeReservationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_reservation" {
+			continue
+		}
+
+		project, err := getTestProject(rs.Primary, config)
+		if err != nil {
+			return err
+		}
+
+		zone := rs.Primary.Attributes["zone"]
+		name := rs.Primary.Attributes["name"]
+
+		url := fmt.Sprintf("https://www.googleapis.com/compute/beta/projects/%s/zones/%s/reservations/%s", project, zone, name)
+		if _, err := sendRequest(config, "GET", url, nil); err == nil {
+			return fmt.Errorf("Error, Reservation %s in zone %s still exists", name, zone)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeReservation_basic(reservationName string, count int) string {
+	return fmt.Sprintf(`
+resource "google_compute_reservation" "reservation" {
+  name = "%s"
+  zone = "us-central1-a"
+
+  specific_reservation {
+    count = %d
+    instance_properties {
+      min_cpu_platform = "Intel Cascade Lake"
+      machine_type     = "n2-standard-2"
+    }
+  }
+}
+`, reservationName, count)
+}
+
+func testAccComputeReservation_accelerators(reservationName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_reservation" "reservation" {
+  name        = "%s"
+  zone        = "us-central1-a"
+  description = "reservation with accelerators"
+
+  specific_reservation_required = true
+
+  specific_reservation {
+    count = 1
+    instance_properties {
+      machine_type = "n1-standard-4"
+      guest_accelerators {
+        accelerator_type  = "nvidia-tesla-k80"
+        accelerator_count = 1
+      }
+      local_ssds {
+        disk_size_gb = 375
+        interface    = "NVME"
+      }
+    }
+  }
+}
+`, reservationName)
+}
@@ -0,0 +1,467 @@
+package google
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeRouterNat() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeRouterNatCreate,
+		Read:   resourceComputeRouterNatRead,
+		Update: resourceComputeRouterNatUpdate,
+		Delete: resourceComputeRouterNatDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeRouterNatImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRFC1035Name(2, 63),
+			},
+			"router": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nat_ip_allocate_option": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AUTO_ONLY", "MANUAL_ONLY"}, false),
+			},
+			"nat_ips": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      selfLinkRelativePathHash,
+			},
+			"source_subnetwork_ip_ranges_to_nat": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ALL_SUBNETWORKS_ALL_IP_RANGES", "ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES", "LIST_OF_SUBNETWORKS"}, false),
+			},
+			"subnetwork": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      routerNatSubnetworkHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+						"source_ip_ranges_to_nat": &schema.Schema{
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"ALL_IP_RANGES", "LIST_OF_SECONDARY_IP_RANGES", "PRIMARY_IP_RANGE"}, false),
+							},
+						},
+						"secondary_ip_range_names": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"min_ports_per_vm": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"udp_idle_timeout_sec": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
+			},
+			"icmp_idle_timeout_sec": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
+			},
+			"tcp_established_idle_timeout_sec": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1200,
+			},
+			"tcp_transitory_idle_timeout_sec": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
+			},
+			"project": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// The vendored compute client predates Cloud NAT, so the NAT configs of a
+// router are read and patched through sendRequest. Like router interfaces
+// and peers they're stored on the router itself, and every change goes
+// through the router lock.
+func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	natName := d.Get("name").(string)
+
+	routerLock := getRouterLockName(region, routerName)
+	mutexKV.Lock(routerLock)
+	defer mutexKV.Unlock(routerLock)
+
+	nats, err := getRouterNats(config, project, region, routerName)
+	if err != nil {
+		return err
+	}
+
+	for _, nat := range nats {
+		if nat.(map[string]interface{})["name"] == natName {
+			return fmt.Errorf("Router %s has NAT %s already", routerName, natName)
+		}
+	}
+
+	nat, err := expandRouterNat(d, config)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Adding NAT %s", natName)
+	nats = append(nats, nat)
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", project, region, routerName, natName))
+	if err := patchRouterNats(config, project, region, routerName, nats); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceComputeRouterNatRead(d, meta)
+}
+
+func resourceComputeRouterNatRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	natName := d.Get("name").(string)
+
+	nats, err := getRouterNats(config, project, region, routerName)
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			log.Printf("[WARN] Removing router NAT %s because its router %s/%s is gone", natName, region, routerName)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	for _, v := range nats {
+		nat := v.(map[string]interface{})
+		if nat["name"] != natName {
+			continue
+		}
+
+		d.Set("nat_ip_allocate_option", nat["natIpAllocateOption"])
+		d.Set("nat_ips", nat["natIps"])
+		d.Set("source_subnetwork_ip_ranges_to_nat", nat["sourceSubnetworkIpRangesToNat"])
+		d.Set("subnetwork", flattenRouterNatSubnetworks(nat["subnetworks"]))
+		d.Set("min_ports_per_vm", flattenRouterNatInt(nat["minPortsPerVm"]))
+		d.Set("udp_idle_timeout_sec", flattenRouterNatInt(nat["udpIdleTimeoutSec"]))
+		d.Set("icmp_idle_timeout_sec", flattenRouterNatInt(nat["icmpIdleTimeoutSec"]))
+		d.Set("tcp_established_idle_timeout_sec", flattenRouterNatInt(nat["tcpEstablishedIdleTimeoutSec"]))
+		d.Set("tcp_transitory_idle_timeout_sec", flattenRouterNatInt(nat["tcpTransitoryIdleTimeoutSec"]))
+		d.Set("region", region)
+		d.Set("project", project)
+		return nil
+	}
+
+	log.Printf("[WARN] Removing router NAT %s/%s/%s because it is gone", region, routerName, natName)
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeRouterNatUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	natName := d.Get("name").(string)
+
+	routerLock := getRouterLockName(region, routerName)
+	mutexKV.Lock(routerLock)
+	defer mutexKV.Unlock(routerLock)
+
+	nats, err := getRouterNats(config, project, region, routerName)
+	if err != nil {
+		return err
+	}
+
+	nat, err := expandRouterNat(d, config)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i, v := range nats {
+		if v.(map[string]interface{})["name"] == natName {
+			nats[i] = nat
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("Router %s/%s has no NAT %s", region, routerName, natName)
+	}
+
+	log.Printf("[INFO] Updating NAT %s", natName)
+	if err := patchRouterNats(config, project, region, routerName, nats); err != nil {
+		return err
+	}
+
+	return resourceComputeRouterNatRead(d, meta)
+}
+
+func resourceComputeRouterNatDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	natName := d.Get("name").(string)
+
+	routerLock := getRouterLockName(region, routerName)
+	mutexKV.Lock(routerLock)
+	defer mutexKV.Unlock(routerLock)
+
+	nats, err := getRouterNats(config, project, region, routerName)
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			log.Printf("[WARN] Removing router NAT %s because its router %s/%s is gone", natName, region, routerName)
+			return nil
+		}
+		return err
+	}
+
+	newNats := make([]interface{}, 0, len(nats))
+	for _, nat := range nats {
+		if nat.(map[string]interface{})["name"] != natName {
+			newNats = append(newNats, nat)
+		}
+	}
+
+	if len(newNats) == len(nats) {
+		log.Printf("[DEBUG] Router %s/%s had no NAT %s already", region, routerName, natName)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] Removing NAT %s from router %s/%s", natName, region, routerName)
+	if err := patchRouterNats(config, project, region, routerName, newNats); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeRouterNatImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	parseImportId([]string{
+		"projects/(?P<project>[^/]+)/regions/(?P<region>[^/]+)/routers/(?P<router>[^/]+)/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<name>[^/]+)",
+		"(?P<region>[^/]+)/(?P<router>[^/]+)/(?P<name>[^/]+)",
+		"(?P<router>[^/]+)/(?P<name>[^/]+)",
+	}, d, config)
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{project}}/{{region}}/{{router}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func routerUrl(project, region, router string) string {
+	return fmt.Sprintf("https://www.googleapis.com/compute/v1/projects/%s/regions/%s/routers/%s", project, region, router)
+}
+
+func getRouterNats(config *Config, project, region, router string) ([]interface{}, error) {
+	res, err := sendRequest(config, "GET", routerUrl(project, region, router), nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error reading router %s/%s: {{err}}", region, router), err)
+	}
+
+	nats, _ := res["nats"].([]interface{})
+	return nats, nil
+}
+
+func patchRouterNats(config *Config, project, region, router string, nats []interface{}) error {
+	log.Printf("[DEBUG] Updating router %s/%s with NATs: %+v", region, router, nats)
+	res, err := sendRequest(config, "PATCH", routerUrl(project, region, router), map[string]interface{}{
+		"nats": nats,
+	})
+	if err != nil {
+		return fmt.Errorf("Error patching router %s/%s: %s", region, router, err)
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	if err := computeOperationWait(config.clientCompute, op, project, "Patching router"); err != nil {
+		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, router, err)
+	}
+	return nil
+}
+
+func expandRouterNat(d *schema.ResourceData, config *Config) (map[string]interface{}, error) {
+	nat := map[string]interface{}{
+		"name":                          d.Get("name"),
+		"natIpAllocateOption":           d.Get("nat_ip_allocate_option"),
+		"sourceSubnetworkIpRangesToNat": d.Get("source_subnetwork_ip_ranges_to_nat"),
+		"udpIdleTimeoutSec":             d.Get("udp_idle_timeout_sec"),
+		"icmpIdleTimeoutSec":            d.Get("icmp_idle_timeout_sec"),
+		"tcpEstablishedIdleTimeoutSec":  d.Get("tcp_established_idle_timeout_sec"),
+		"tcpTransitoryIdleTimeoutSec":   d.Get("tcp_transitory_idle_timeout_sec"),
+	}
+
+	if v, ok := d.GetOk("min_ports_per_vm"); ok {
+		nat["minPortsPerVm"] = v
+	}
+
+	natIps := make([]interface{}, 0)
+	for _, v := range d.Get("nat_ips").(*schema.Set).List() {
+		natIps = append(natIps, v)
+	}
+	nat["natIps"] = natIps
+
+	subnetworks := make([]interface{}, 0)
+	for _, raw := range d.Get("subnetwork").(*schema.Set).List() {
+		s := raw.(map[string]interface{})
+
+		subnetwork, err := ParseSubnetworkFieldValue(s["name"].(string), d, config)
+		if err != nil {
+			return nil, err
+		}
+
+		subnetworks = append(subnetworks, map[string]interface{}{
+			"name":                  subnetwork.RelativeLink(),
+			"sourceIpRangesToNat":   convertStringSet(s["source_ip_ranges_to_nat"].(*schema.Set)),
+			"secondaryIpRangeNames": convertStringSet(s["secondary_ip_range_names"].(*schema.Set)),
+		})
+	}
+	nat["subnetworks"] = subnetworks
+
+	return nat, nil
+}
+
+func flattenRouterNatSubnetworks(v interface{}) []map[string]interface{} {
+	subnetworks := make([]map[string]interface{}, 0)
+	raw, _ := v.([]interface{})
+	for _, r := range raw {
+		s := r.(map[string]interface{})
+		subnetworks = append(subnetworks, map[string]interface{}{
+			"name":                     s["name"],
+			"source_ip_ranges_to_nat":  s["sourceIpRangesToNat"],
+			"secondary_ip_range_names": s["secondaryIpRangeNames"],
+		})
+	}
+	return subnetworks
+}
+
+// Subnetworks are hashed by name, they may be given as names or self links.
+func routerNatSubnetworkHash(v interface{}) int {
+	var buf bytes.Buffer
+	s := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", GetResourceNameFromSelfLink(s["name"].(string))))
+	for _, r := range sortedStringSet(s["source_ip_ranges_to_nat"]) {
+		buf.WriteString(fmt.Sprintf("%s-", r))
+	}
+	buf.WriteString("|")
+	for _, r := range sortedStringSet(s["secondary_ip_range_names"]) {
+		buf.WriteString(fmt.Sprintf("%s-", r))
+	}
+
+	return hashcode.String(buf.String())
+}
+
+func sortedStringSet(v interface{}) []string {
+	var values []string
+	switch s := v.(type) {
+	case *schema.Set:
+		values = convertStringSet(s)
+	case []interface{}:
+		values = convertStringArr(s)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// JSON numbers are decoded as float64.
+func flattenRouterNatInt(v interface{}) int {
+	f, _ := v.(float64)
+	return int(f)
+}
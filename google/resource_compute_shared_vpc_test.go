@@ -100,6 +100,7 @@ resource "google_project" "host" {
 	name            = "%s"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project" "service" {
@@ -107,6 +108,7 @@ resource "google_project" "service" {
 	name            = "%s"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "host" {
@@ -138,6 +140,7 @@ resource "google_project" "host" {
 	name            = "%s"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project" "service" {
@@ -145,6 +148,7 @@ resource "google_project" "service" {
 	name            = "%s"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "host" {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	computeBeta "google.golang.org/api/compute/v0.beta"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
 )
@@ -32,10 +33,19 @@ func resourceComputeSnapshot() *schema.Resource {
 			},
 
 			"snapshot_encryption_key_raw": &schema.Schema{
-				Type:      schema.TypeString,
-				Optional:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"snapshot_encryption_kms_key_self_link"},
+			},
+
+			"snapshot_encryption_kms_key_self_link": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareCryptoKeyVersions,
+				ConflictsWith:    []string{"snapshot_encryption_key_raw"},
 			},
 
 			"snapshot_encryption_key_sha256": &schema.Schema{
@@ -44,10 +54,19 @@ func resourceComputeSnapshot() *schema.Resource {
 			},
 
 			"source_disk_encryption_key_raw": &schema.Schema{
-				Type:      schema.TypeString,
-				Optional:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"source_disk_encryption_kms_key_self_link"},
+			},
+
+			"source_disk_encryption_kms_key_self_link": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareCryptoKeyVersions,
+				ConflictsWith:    []string{"source_disk_encryption_key_raw"},
 			},
 
 			"source_disk_encryption_key_sha256": &schema.Schema{
@@ -107,28 +126,38 @@ func resourceComputeSnapshotCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Build the snapshot parameter
-	snapshot := &compute.Snapshot{
+	snapshot := &computeBeta.Snapshot{
 		Name: d.Get("name").(string),
 	}
 
 	source_disk := d.Get("source_disk").(string)
 
 	if v, ok := d.GetOk("snapshot_encryption_key_raw"); ok {
-		snapshot.SnapshotEncryptionKey = &compute.CustomerEncryptionKey{}
+		snapshot.SnapshotEncryptionKey = &computeBeta.CustomerEncryptionKey{}
 		snapshot.SnapshotEncryptionKey.RawKey = v.(string)
 	}
 
+	if v, ok := d.GetOk("snapshot_encryption_kms_key_self_link"); ok {
+		snapshot.SnapshotEncryptionKey = &computeBeta.CustomerEncryptionKey{}
+		snapshot.SnapshotEncryptionKey.KmsKeyName = v.(string)
+	}
+
 	if v, ok := d.GetOk("source_disk_encryption_key_raw"); ok {
-		snapshot.SourceDiskEncryptionKey = &compute.CustomerEncryptionKey{}
+		snapshot.SourceDiskEncryptionKey = &computeBeta.CustomerEncryptionKey{}
 		snapshot.SourceDiskEncryptionKey.RawKey = v.(string)
 	}
 
+	if v, ok := d.GetOk("source_disk_encryption_kms_key_self_link"); ok {
+		snapshot.SourceDiskEncryptionKey = &computeBeta.CustomerEncryptionKey{}
+		snapshot.SourceDiskEncryptionKey.KmsKeyName = v.(string)
+	}
+
 	zone, err := getZone(d, config)
 	if err != nil {
 		return err
 	}
 
-	op, err := config.clientCompute.Disks.CreateSnapshot(
+	op, err := config.clientComputeBeta.Disks.CreateSnapshot(
 		project, zone, source_disk, snapshot).Do()
 	if err != nil {
 		return fmt.Errorf("Error creating snapshot: %s", err)
@@ -138,7 +167,7 @@ func resourceComputeSnapshotCreate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(snapshot.Name)
 
 	timeout := int(d.Timeout(schema.TimeoutCreate).Minutes())
-	err = computeOperationWaitTime(config.clientCompute, op, project, "Creating Snapshot", timeout)
+	err = computeSharedOperationWaitTime(config.clientCompute, op, project, timeout, "Creating Snapshot")
 	if err != nil {
 		return err
 	}
@@ -172,7 +201,7 @@ func resourceComputeSnapshotRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	snapshot, err := config.clientCompute.Snapshots.Get(
+	snapshot, err := config.clientComputeBeta.Snapshots.Get(
 		project, d.Id()).Do()
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Snapshot %q", d.Get("name").(string)))
@@ -182,12 +211,19 @@ func resourceComputeSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("source_disk_link", snapshot.SourceDisk)
 	d.Set("name", snapshot.Name)
 
-	if snapshot.SnapshotEncryptionKey != nil && snapshot.SnapshotEncryptionKey.Sha256 != "" {
-		d.Set("snapshot_encryption_key_sha256", snapshot.SnapshotEncryptionKey.Sha256)
+	if snapshot.SnapshotEncryptionKey != nil {
+		if snapshot.SnapshotEncryptionKey.Sha256 != "" {
+			d.Set("snapshot_encryption_key_sha256", snapshot.SnapshotEncryptionKey.Sha256)
+		}
+		// The crypto key version in use is returned
+		d.Set("snapshot_encryption_kms_key_self_link", snapshot.SnapshotEncryptionKey.KmsKeyName)
 	}
 
-	if snapshot.SourceDiskEncryptionKey != nil && snapshot.SourceDiskEncryptionKey.Sha256 != "" {
-		d.Set("source_disk_encryption_key_sha256", snapshot.SourceDiskEncryptionKey.Sha256)
+	if snapshot.SourceDiskEncryptionKey != nil {
+		if snapshot.SourceDiskEncryptionKey.Sha256 != "" {
+			d.Set("source_disk_encryption_key_sha256", snapshot.SourceDiskEncryptionKey.Sha256)
+		}
+		d.Set("source_disk_encryption_kms_key_self_link", snapshot.SourceDiskEncryptionKey.KmsKeyName)
 	}
 
 	d.Set("labels", snapshot.Labels)
@@ -73,6 +73,50 @@ func TestAccComputeVpnTunnel_defaultTrafficSelectors(t *testing.T) {
 	})
 }
 
+func TestAccComputeVpnTunnel_haVpnGateway(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeVpnTunnelDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeVpnTunnel_haVpnGateway(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_compute_vpn_tunnel.tunnel1", "vpn_gateway_interface", "0"),
+					resource.TestCheckResourceAttr(
+						"google_compute_vpn_tunnel.tunnel2", "vpn_gateway_interface", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeVpnTunnel_externalVpnGateway(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeVpnTunnelDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeVpnTunnel_externalVpnGateway(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_compute_vpn_tunnel.tunnel1", "peer_external_gateway_interface", "0"),
+					resource.TestCheckResourceAttr(
+						"google_compute_vpn_tunnel.tunnel2", "peer_external_gateway_interface", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeVpnTunnelDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	project := config.Project
@@ -292,3 +336,121 @@ resource "google_compute_vpn_tunnel" "foobar" {
 		acctest.RandString(10), acctest.RandString(10), acctest.RandString(10),
 		acctest.RandString(10))
 }
+
+func testAccComputeVpnTunnel_haVpnGateway(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "network1" {
+  name                    = "tunnel-test-network1-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_network" "network2" {
+  name                    = "tunnel-test-network2-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_ha_vpn_gateway" "ha_gateway1" {
+  region  = "us-central1"
+  name    = "tunnel-test-gateway1-%s"
+  network = "${google_compute_network.network1.self_link}"
+}
+
+resource "google_compute_ha_vpn_gateway" "ha_gateway2" {
+  region  = "us-central1"
+  name    = "tunnel-test-gateway2-%s"
+  network = "${google_compute_network.network2.self_link}"
+}
+
+resource "google_compute_router" "router1" {
+  region  = "us-central1"
+  name    = "tunnel-test-router1-%s"
+  network = "${google_compute_network.network1.self_link}"
+  bgp {
+    asn = 64514
+  }
+}
+
+resource "google_compute_vpn_tunnel" "tunnel1" {
+  region                = "us-central1"
+  name                  = "tunnel-test-tunnel1-%s"
+  vpn_gateway           = "${google_compute_ha_vpn_gateway.ha_gateway1.self_link}"
+  vpn_gateway_interface = 0
+  peer_gcp_gateway      = "${google_compute_ha_vpn_gateway.ha_gateway2.self_link}"
+  shared_secret         = "a secret message"
+  router                = "${google_compute_router.router1.name}"
+}
+
+resource "google_compute_vpn_tunnel" "tunnel2" {
+  region                = "us-central1"
+  name                  = "tunnel-test-tunnel2-%s"
+  vpn_gateway           = "${google_compute_ha_vpn_gateway.ha_gateway1.self_link}"
+  vpn_gateway_interface = 1
+  peer_gcp_gateway      = "${google_compute_ha_vpn_gateway.ha_gateway2.self_link}"
+  shared_secret         = "a secret message"
+  router                = "${google_compute_router.router1.name}"
+}
+`, suffix, suffix, suffix, suffix, suffix, suffix, suffix)
+}
+
+func testAccComputeVpnTunnel_externalVpnGateway(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "network" {
+  name                    = "tunnel-test-network-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_ha_vpn_gateway" "ha_gateway" {
+  region  = "us-central1"
+  name    = "tunnel-test-gateway-%s"
+  network = "${google_compute_network.network.self_link}"
+}
+
+resource "google_compute_external_vpn_gateway" "external_gateway" {
+  name            = "tunnel-test-external-%s"
+  redundancy_type = "TWO_IPS_REDUNDANCY"
+
+  interface {
+    id         = 0
+    ip_address = "8.8.8.8"
+  }
+
+  interface {
+    id         = 1
+    ip_address = "8.8.4.4"
+  }
+}
+
+resource "google_compute_router" "router" {
+  region  = "us-central1"
+  name    = "tunnel-test-router-%s"
+  network = "${google_compute_network.network.self_link}"
+  bgp {
+    asn = 64514
+  }
+}
+
+resource "google_compute_vpn_tunnel" "tunnel1" {
+  region                          = "us-central1"
+  name                            = "tunnel-test-tunnel1-%s"
+  vpn_gateway                     = "${google_compute_ha_vpn_gateway.ha_gateway.self_link}"
+  vpn_gateway_interface           = 0
+  peer_external_gateway           = "${google_compute_external_vpn_gateway.external_gateway.self_link}"
+  peer_external_gateway_interface = 0
+  shared_secret                   = "a secret message"
+  router                          = "${google_compute_router.router.name}"
+  ike_version                     = 2
+}
+
+resource "google_compute_vpn_tunnel" "tunnel2" {
+  region                          = "us-central1"
+  name                            = "tunnel-test-tunnel2-%s"
+  vpn_gateway                     = "${google_compute_ha_vpn_gateway.ha_gateway.self_link}"
+  vpn_gateway_interface           = 1
+  peer_external_gateway           = "${google_compute_external_vpn_gateway.external_gateway.self_link}"
+  peer_external_gateway_interface = 1
+  shared_secret                   = "a secret message"
+  router                          = "${google_compute_router.router.name}"
+  ike_version                     = 2
+}
+`, suffix, suffix, suffix, suffix, suffix, suffix)
+}
@@ -661,7 +661,12 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 	defer mutexKV.Unlock(containerClusterMutexKey(project, location, clusterName))
 
 	parent := fmt.Sprintf("projects/%s/locations/%s", project, location)
-	op, err := config.clientContainerBeta.Projects.Locations.Clusters.Create(parent, req).Do()
+	var op *containerBeta.Operation
+	if containerClusterHasReservationAffinity(d) {
+		op, err = createContainerClusterWithReservationAffinities(d, config, parent, req)
+	} else {
+		op, err = config.clientContainerBeta.Projects.Locations.Clusters.Create(parent, req).Do()
+	}
 	if err != nil {
 		return err
 	}
@@ -753,7 +758,14 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("monitoring_service", cluster.MonitoringService)
 	d.Set("network", cluster.NetworkConfig.Network)
 	d.Set("subnetwork", cluster.NetworkConfig.Subnetwork)
-	if err := d.Set("node_config", flattenNodeConfig(cluster.NodeConfig)); err != nil {
+	// Read the reservation affinities of the node configs from the raw cluster.
+	rawCluster, err := sendRequest(config, "GET", "https://container.googleapis.com/v1beta1/"+containerClusterFullName(project, location, cluster.Name), nil)
+	if err != nil {
+		return err
+	}
+	nodeConfig := flattenNodeConfig(cluster.NodeConfig)
+	flattenNodeConfigReservationAffinity(nodeConfig, rawCluster["nodeConfig"])
+	if err := d.Set("node_config", nodeConfig); err != nil {
 		return err
 	}
 	d.Set("project", project)
@@ -765,6 +777,14 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
+	rawNodePools, _ := rawCluster["nodePools"].([]interface{})
+	for _, np := range nps {
+		for _, raw := range rawNodePools {
+			if rawNodePool, ok := raw.(map[string]interface{}); ok && rawNodePool["name"] == np["name"] {
+				flattenNodeConfigReservationAffinity(np["node_config"].([]map[string]interface{}), rawNodePool["config"])
+			}
+		}
+	}
 	if err := d.Set("node_pool", nps); err != nil {
 		return err
 	}
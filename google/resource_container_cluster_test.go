@@ -2231,6 +2231,7 @@ resource "google_project" "host_project" {
 	project_id      = "%s-host"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "host_project" {
@@ -2247,6 +2248,7 @@ resource "google_project" "service_project" {
 	project_id      = "%s-service"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_service" "service_project" {
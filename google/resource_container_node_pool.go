@@ -223,8 +223,12 @@ func resourceContainerNodePoolCreate(d *schema.ResourceData, meta interface{}) e
 
 	var operation *containerBeta.Operation
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		operation, err = config.clientContainerBeta.
-			Projects.Locations.Clusters.NodePools.Create(nodePoolInfo.parent(), req).Do()
+		if affinity := expandNodeConfigReservationAffinity(d.Get("node_config")); affinity != nil {
+			operation, err = createContainerNodePoolWithReservationAffinity(config, nodePoolInfo.parent(), req, affinity)
+		} else {
+			operation, err = config.clientContainerBeta.
+				Projects.Locations.Clusters.NodePools.Create(nodePoolInfo.parent(), req).Do()
+		}
 
 		if err != nil {
 			if isFailedPreconditionError(err) {
@@ -291,6 +295,13 @@ func resourceContainerNodePoolRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	// Read the reservation affinity of the node config from the raw node pool.
+	rawNodePool, err := sendRequest(config, "GET", "https://container.googleapis.com/v1beta1/"+nodePoolInfo.fullyQualifiedName(name), nil)
+	if err != nil {
+		return err
+	}
+	flattenNodeConfigReservationAffinity(npMap["node_config"].([]map[string]interface{}), rawNodePool["config"])
+
 	for k, v := range npMap {
 		d.Set(k, v)
 	}
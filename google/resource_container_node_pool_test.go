@@ -163,6 +163,34 @@ func TestAccContainerNodePool_withWorkloadMetadataConfig(t *testing.T) {
 	})
 }
 
+func TestAccContainerNodePool_withReservationAffinity(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	np := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	reservation := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerNodePoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerNodePool_withReservationAffinity(cluster, np, reservation),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_node_pool.with_reservation_affinity",
+						"node_config.0.reservation_affinity.0.type", "SPECIFIC_RESERVATION"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_container_node_pool.with_reservation_affinity",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccContainerNodePool_withGPU(t *testing.T) {
 	t.Parallel()
 
@@ -773,6 +801,49 @@ resource "google_container_node_pool" "np_with_node_config" {
 }`, acctest.RandString(10), acctest.RandString(10))
 }
 
+func testAccContainerNodePool_withReservationAffinity(cluster, np, reservation string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "cluster" {
+  name               = "%s"
+  zone               = "us-central1-a"
+  initial_node_count = 1
+}
+
+resource "google_compute_reservation" "reservation" {
+  name = "%s"
+  zone = "us-central1-a"
+
+  specific_reservation_required = true
+
+  specific_reservation {
+    count = 1
+    instance_properties {
+      machine_type = "n1-standard-1"
+    }
+  }
+}
+
+resource "google_container_node_pool" "with_reservation_affinity" {
+  name               = "%s"
+  zone               = "us-central1-a"
+  cluster            = "${google_container_cluster.cluster.name}"
+  initial_node_count = 1
+
+  node_config {
+    machine_type = "n1-standard-1"
+
+    reservation_affinity {
+      type = "SPECIFIC_RESERVATION"
+
+      specific_reservation {
+        key    = "compute.googleapis.com/reservation-name"
+        values = ["${google_compute_reservation.reservation.name}"]
+      }
+    }
+  }
+}`, cluster, reservation, np)
+}
+
 func testAccContainerNodePool_withWorkloadMetadataConfig() string {
 	return fmt.Sprintf(`
 data "google_container_engine_versions" "central1a" {
@@ -107,6 +107,27 @@ func TestAccFolderOrganizationPolicy_list_denySome(t *testing.T) {
 	})
 }
 
+func TestAccFolderOrganizationPolicy_list_inheritFromParent(t *testing.T) {
+	t.Parallel()
+
+	folder := acctest.RandomWithPrefix("tf-test")
+	org := getTestOrgFromEnv(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGoogleFolderOrganizationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderOrganizationPolicy_list_inheritFromParent(org, folder),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleFolderOrganizationListPolicyDeniedValues("list", DENIED_ORG_POLICIES),
+					testAccCheckGoogleFolderOrganizationListPolicyInheritFromParent("list", true),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFolderOrganizationPolicy_list_update(t *testing.T) {
 	t.Parallel()
 
@@ -240,6 +261,21 @@ func testAccCheckGoogleFolderOrganizationListPolicyDeniedValues(n string, values
 	}
 }
 
+func testAccCheckGoogleFolderOrganizationListPolicyInheritFromParent(n string, inherit bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policy, err := getGoogleFolderOrganizationPolicyTestResource(s, n)
+		if err != nil {
+			return err
+		}
+
+		if policy.ListPolicy.InheritFromParent != inherit {
+			return fmt.Errorf("Expected the list policy inherit_from_parent to be '%t', got '%t'", inherit, policy.ListPolicy.InheritFromParent)
+		}
+
+		return nil
+	}
+}
+
 func getGoogleFolderOrganizationRestoreDefaultTrue(n string, policyDefault *cloudresourcemanager.RestoreDefault) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -357,6 +393,31 @@ resource "google_folder_organization_policy" "list" {
 `, folder, "organizations/"+org)
 }
 
+func testAccFolderOrganizationPolicy_list_inheritFromParent(org, folder string) string {
+	return fmt.Sprintf(`
+resource "google_folder" "orgpolicy" {
+  display_name = "%s"
+  parent       = "%s"
+}
+
+resource "google_folder_organization_policy" "list" {
+  folder     = "${google_folder.orgpolicy.name}"
+  constraint = "serviceuser.services"
+
+  list_policy {
+    inherit_from_parent = true
+
+    deny {
+      values = [
+        "doubleclicksearch.googleapis.com",
+        "replicapoolupdater.googleapis.com",
+      ]
+    }
+  }
+}
+`, folder, "organizations/"+org)
+}
+
 func testAccFolderOrganizationPolicy_restore_defaultTrue(org, folder string) string {
 	return fmt.Sprintf(`
 resource "google_folder" "orgpolicy" {
@@ -18,6 +18,8 @@ func resourceGoogleOrganizationIamCustomRole() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customRolePermissionsCustomizeDiff(organizationCustomRoleFullResourceName),
+
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:     schema.TypeString,
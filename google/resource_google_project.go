@@ -8,8 +8,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"golang.org/x/net/context"
 	appengine "google.golang.org/api/appengine/v1"
 	"google.golang.org/api/cloudbilling/v1"
 	"google.golang.org/api/cloudresourcemanager/v1"
@@ -30,8 +32,12 @@ func resourceGoogleProject() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceProjectImportState,
 		},
-		MigrateState:  resourceGoogleProjectMigrateState,
-		CustomizeDiff: resourceGoogleProjectCustomizeDiff,
+		MigrateState: resourceGoogleProjectMigrateState,
+		CustomizeDiff: customdiff.All(
+			resourceGoogleProjectCustomizeDiff,
+			resourceGoogleProjectDeletionLienCustomizeDiff,
+			resourceGoogleProjectReplacementCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"project_id": &schema.Schema{
@@ -45,6 +51,15 @@ func resourceGoogleProject() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"deletion_policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DELETE", "ABANDON", "PREVENT"}, false),
+			},
+			"deletion_lien": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"auto_create_network": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -216,6 +231,52 @@ func resourceGoogleProjectCustomizeDiff(diff *schema.ResourceDiff, meta interfac
 	return nil
 }
 
+// resourceGoogleProjectDeletionLienCustomizeDiff plans the creation of the
+// deletion lien of a PREVENT project when it was removed outside of Terraform.
+func resourceGoogleProjectDeletionLienCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.Get("deletion_policy").(string) != "PREVENT" {
+		return nil
+	}
+
+	if lien, _ := diff.GetChange("deletion_lien"); lien.(string) == "" {
+		return diff.SetNewComputed("deletion_lien")
+	}
+	return nil
+}
+
+// resourceGoogleProjectReplacementCustomizeDiff guards plans that replace,
+// and so delete, an existing project. Replacing a PREVENT project fails the
+// plan, and so does replacing a project that is billed and has enabled
+// services unless its deletion_policy is set. Destroy plans don't run
+// provider code, so resourceGoogleProjectDelete makes the same check.
+func resourceGoogleProjectReplacementCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	oldAppEngine, newAppEngine := diff.GetChange("app_engine.#")
+	appEngineRemoved := oldAppEngine != nil && newAppEngine != nil && oldAppEngine.(int) > 0 && newAppEngine.(int) < 1
+	oldLocation, _ := diff.GetChange("app_engine.0.location_id")
+	locationChanged := diff.HasChange("app_engine.0.location_id") && oldLocation != nil && oldLocation.(string) != ""
+	if !diff.HasChange("project_id") && !appEngineRemoved && !locationChanged {
+		return nil
+	}
+
+	pid := diff.Id()
+	oldPolicy, newPolicy := diff.GetChange("deletion_policy")
+	if oldPolicy.(string) == "PREVENT" {
+		return fmt.Errorf("Project %q would be replaced, but its deletion_policy is PREVENT. Set deletion_policy to DELETE and apply before making changes that replace the project.", pid)
+	}
+
+	if newPolicy.(string) == "" && !diff.Get("skip_delete").(bool) {
+		config := meta.(*Config)
+		if warning := projectDeletionWarning(pid, config); warning != "" {
+			return fmt.Errorf("Project %q would be replaced, but %s. Set deletion_policy to DELETE to allow deleting it.", pid, warning)
+		}
+	}
+	return nil
+}
+
 func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -278,6 +339,14 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	// A new project has no lien to remove, so only look up liens when one
+	// is needed, listing them requires additional permissions.
+	if d.Get("deletion_policy").(string) == "PREVENT" {
+		if err = reconcileProjectDeletionLien(d, config); err != nil {
+			return err
+		}
+	}
+
 	err = resourceGoogleProjectRead(d, meta)
 	if err != nil {
 		return err
@@ -370,6 +439,16 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("billing_account", _ba)
 	}
 
+	// Only look up the deletion lien when it may exist, listing liens requires
+	// additional permissions.
+	if d.Get("deletion_policy").(string) == "PREVENT" || d.Get("deletion_lien").(string) != "" {
+		lien, err := getProjectDeletionLien(pid, config)
+		if err != nil {
+			return err
+		}
+		d.Set("deletion_lien", lien)
+	}
+
 	// read the App Engine app, if one exists
 	// we don't have the config available for import, so we can't rely on
 	// that to read it. And honestly, we want to know if an App exists that
@@ -527,6 +606,14 @@ func resourceGoogleProjectUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	// Deletion policy has changed, or the deletion lien was removed
+	if d.HasChange("deletion_policy") || d.HasChange("deletion_lien") {
+		if err := reconcileProjectDeletionLien(d, config); err != nil {
+			return err
+		}
+		d.SetPartial("deletion_policy")
+	}
+
 	d.Partial(false)
 
 	return resourceGoogleProjectRead(d, meta)
@@ -534,9 +621,28 @@ func resourceGoogleProjectUpdate(d *schema.ResourceData, meta interface{}) error
 
 func resourceGoogleProjectDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	// Only delete projects if skip_delete isn't set
-	if !d.Get("skip_delete").(bool) {
-		pid := d.Id()
+	pid := d.Id()
+
+	switch {
+	case d.Get("deletion_policy").(string) == "PREVENT":
+		return fmt.Errorf("Cannot delete project %q, its deletion_policy is PREVENT. Set deletion_policy to DELETE or ABANDON and apply before destroying it.", pid)
+	case d.Get("deletion_policy").(string) == "ABANDON" || d.Get("skip_delete").(bool):
+		log.Printf("[DEBUG] Removing project %q from state without deleting it", pid)
+	default:
+		if d.Get("deletion_policy").(string) == "" {
+			if warning := projectDeletionWarning(pid, config); warning != "" {
+				return fmt.Errorf("Cannot delete project %q, %s. Set deletion_policy to DELETE and apply before destroying it.", pid, warning)
+			}
+		}
+
+		// Remove a lien left over from a previous PREVENT policy, as it
+		// would block the deletion.
+		if d.Get("deletion_lien").(string) != "" {
+			if err := reconcileProjectDeletionLien(d, config); err != nil {
+				return err
+			}
+		}
+
 		_, err := config.clientResourceManager.Projects.Delete(pid).Do()
 		if err != nil {
 			return fmt.Errorf("Error deleting project %q: %s", pid, err)
@@ -553,6 +659,89 @@ func resourceProjectImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 	return []*schema.ResourceData{d}, nil
 }
 
+const (
+	projectDeletionLienOrigin      = "terraform-deletion-policy"
+	projectDeletionLienRestriction = "resourcemanager.projects.delete"
+)
+
+// getProjectDeletionLien returns the name of the lien managed through the
+// deletion_policy of a project, or "" if there is none.
+func getProjectDeletionLien(pid string, config *Config) (string, error) {
+	var lien string
+	err := config.clientResourceManager.Liens.List().Parent(prefixedProject(pid)).
+		Pages(context.Background(), func(resp *cloudresourcemanager.ListLiensResponse) error {
+			for _, l := range resp.Liens {
+				if l.Origin == projectDeletionLienOrigin {
+					lien = l.Name
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return "", fmt.Errorf("Error listing liens on project %q: %s", pid, err)
+	}
+	return lien, nil
+}
+
+// reconcileProjectDeletionLien places a lien preventing the deletion of the
+// project when its deletion_policy is PREVENT, and removes it otherwise.
+func reconcileProjectDeletionLien(d *schema.ResourceData, config *Config) error {
+	pid := d.Id()
+	prevent := d.Get("deletion_policy").(string) == "PREVENT"
+
+	lien, err := getProjectDeletionLien(pid, config)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case prevent && lien == "":
+		l, err := config.clientResourceManager.Liens.Create(&cloudresourcemanager.Lien{
+			Parent:       prefixedProject(pid),
+			Restrictions: []string{projectDeletionLienRestriction},
+			Origin:       projectDeletionLienOrigin,
+			Reason:       "The deletion_policy of this project is PREVENT in Terraform",
+		}).Do()
+		if err != nil {
+			return fmt.Errorf("Error creating deletion lien on project %q: %s", pid, err)
+		}
+		lien = l.Name
+	case !prevent && lien != "":
+		if _, err := config.clientResourceManager.Liens.Delete(lien).Do(); err != nil && !isGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error deleting deletion lien %q on project %q: %s", lien, pid, err)
+		}
+		lien = ""
+	}
+
+	d.Set("deletion_lien", lien)
+	return nil
+}
+
+// projectDeletionWarning describes what deleting a project would take down
+// when it has billing enabled and services in use, or returns "".
+func projectDeletionWarning(pid string, config *Config) string {
+	billing, err := config.clientBilling.Projects.GetBillingInfo(prefixedProject(pid)).Do()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read billing info of project %q: %s", pid, err)
+		return ""
+	}
+	if !billing.BillingEnabled {
+		return ""
+	}
+
+	services, err := getApiServices(pid, config, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to list enabled services of project %q: %s", pid, err)
+		return ""
+	}
+	if len(services) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("billing is enabled through %s and %d services are enabled (%s)",
+		billing.BillingAccountName, len(services), strings.Join(services, ", "))
+}
+
 // Delete a compute network along with the firewall rules inside it.
 func forceDeleteComputeNetwork(projectId, networkName string, config *Config) error {
 	networkLink := fmt.Sprintf("https://www.googleapis.com/compute/v1/projects/%s/global/networks/%s", projectId, networkName)
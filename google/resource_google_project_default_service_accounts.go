@@ -0,0 +1,236 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"golang.org/x/net/context"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/iam/v1"
+)
+
+// The role granted to the default service accounts when they're created.
+const defaultServiceAccountRole = "roles/editor"
+
+func resourceGoogleProjectDefaultServiceAccounts() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGoogleProjectDefaultServiceAccountsCreate,
+		Read:   resourceGoogleProjectDefaultServiceAccountsRead,
+		Update: resourceGoogleProjectDefaultServiceAccountsUpdate,
+		Delete: resourceGoogleProjectDefaultServiceAccountsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DISABLE", "DELETE", "DEPRIVILEGE"}, false),
+			},
+			"restore_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "REVERT",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "REVERT", "REVERT_AND_IGNORE_FAILURE"}, false),
+			},
+			"service_accounts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceGoogleProjectDefaultServiceAccountsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	pid := d.Get("project").(string)
+	action := d.Get("action").(string)
+
+	accounts, err := getProjectDefaultServiceAccounts(pid, config)
+	if err != nil {
+		return err
+	}
+
+	serviceAccounts := make(map[string]interface{}, len(accounts))
+	for _, sa := range accounts {
+		log.Printf("[DEBUG] Applying action %s to default service account %q", action, sa.Email)
+		if err := applyDefaultServiceAccountAction(action, pid, sa.Email, sa.UniqueId, config); err != nil {
+			return err
+		}
+		serviceAccounts[sa.Email] = sa.UniqueId
+	}
+
+	d.SetId("projects/" + pid)
+	d.Set("service_accounts", serviceAccounts)
+
+	return resourceGoogleProjectDefaultServiceAccountsRead(d, meta)
+}
+
+func resourceGoogleProjectDefaultServiceAccountsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	pid := strings.TrimPrefix(d.Id(), "projects/")
+
+	// The accounts themselves may be gone, only the project is checked.
+	if _, err := config.clientResourceManager.Projects.Get(pid).Do(); err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Project %q", pid))
+	}
+
+	d.Set("project", pid)
+	return nil
+}
+
+func resourceGoogleProjectDefaultServiceAccountsUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only restore_policy can be updated, it's only used on delete.
+	return resourceGoogleProjectDefaultServiceAccountsRead(d, meta)
+}
+
+func resourceGoogleProjectDefaultServiceAccountsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	pid := strings.TrimPrefix(d.Id(), "projects/")
+	action := d.Get("action").(string)
+	restorePolicy := d.Get("restore_policy").(string)
+
+	if restorePolicy == "NONE" {
+		log.Printf("[DEBUG] Not restoring the default service accounts of project %q, restore_policy is NONE", pid)
+		d.SetId("")
+		return nil
+	}
+
+	for email, uniqueId := range d.Get("service_accounts").(map[string]interface{}) {
+		err := restoreDefaultServiceAccount(action, pid, email, uniqueId.(string), config)
+		if err != nil {
+			if restorePolicy != "REVERT_AND_IGNORE_FAILURE" {
+				return err
+			}
+			log.Printf("[WARN] %s", err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getProjectDefaultServiceAccounts returns the Compute Engine and App Engine
+// default service accounts of a project that exist.
+func getProjectDefaultServiceAccounts(pid string, config *Config) ([]*iam.ServiceAccount, error) {
+	// The Compute Engine default service account is named after the project
+	// number, as returned by google_compute_default_service_account. Looking
+	// it up through Resource Manager works without the Compute API enabled.
+	project, err := config.clientResourceManager.Projects.Get(pid).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving project %q: %s", pid, err)
+	}
+
+	defaults := map[string]struct{}{
+		fmt.Sprintf("%d-compute@developer.gserviceaccount.com", project.ProjectNumber): struct{}{},
+		fmt.Sprintf("%s@appspot.gserviceaccount.com", pid):                             struct{}{},
+	}
+
+	accounts := make([]*iam.ServiceAccount, 0, len(defaults))
+	err = config.clientIAM.Projects.ServiceAccounts.List("projects/"+pid).Pages(context.Background(), func(r *iam.ListServiceAccountsResponse) error {
+		for _, sa := range r.Accounts {
+			if _, ok := defaults[sa.Email]; ok {
+				accounts = append(accounts, sa)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing service accounts of project %q: %s", pid, err)
+	}
+
+	return accounts, nil
+}
+
+func applyDefaultServiceAccountAction(action, pid, email, uniqueId string, config *Config) error {
+	name := "projects/-/serviceAccounts/" + uniqueId
+
+	switch action {
+	case "DISABLE":
+		return setServiceAccountDisabled(config, name, true)
+	case "DELETE":
+		if _, err := config.clientIAM.Projects.ServiceAccounts.Delete(name).Do(); err != nil {
+			return fmt.Errorf("Error deleting service account %q: %s", email, err)
+		}
+		return nil
+	case "DEPRIVILEGE":
+		return setDefaultServiceAccountRole(pid, email, false, config)
+	}
+
+	return fmt.Errorf("Unsupported action %q", action)
+}
+
+func restoreDefaultServiceAccount(action, pid, email, uniqueId string, config *Config) error {
+	name := "projects/-/serviceAccounts/" + uniqueId
+
+	switch action {
+	case "DISABLE":
+		return setServiceAccountDisabled(config, name, false)
+	case "DELETE":
+		// Deleted service accounts can only be undeleted for 30 days.
+		if _, err := sendRequest(config, "POST", iamBasePath+name+":undelete", nil); err != nil {
+			return fmt.Errorf("Error undeleting service account %q: %s", email, err)
+		}
+		return nil
+	case "DEPRIVILEGE":
+		return setDefaultServiceAccountRole(pid, email, true, config)
+	}
+
+	return fmt.Errorf("Unsupported action %q", action)
+}
+
+// setDefaultServiceAccountRole grants or revokes the role the default service
+// accounts are created with on the project.
+func setDefaultServiceAccountRole(pid, email string, granted bool, config *Config) error {
+	updater := &ProjectIamUpdater{
+		resourceId: pid,
+		Config:     config,
+	}
+	member := "serviceAccount:" + email
+
+	err := iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
+		p.Bindings = setIamBindingMember(p.Bindings, defaultServiceAccountRole, member, granted)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating %s of service account %q: %s", defaultServiceAccountRole, email, err)
+	}
+	return nil
+}
+
+// setIamBindingMember adds member to or removes it from the binding for role,
+// dropping the binding when it has no members left.
+func setIamBindingMember(bindings []*cloudresourcemanager.Binding, role, member string, present bool) []*cloudresourcemanager.Binding {
+	if present {
+		return mergeBindings(append(bindings, &cloudresourcemanager.Binding{
+			Role:    role,
+			Members: []string{member},
+		}))
+	}
+
+	result := make([]*cloudresourcemanager.Binding, 0, len(bindings))
+	for _, b := range bindings {
+		if b.Role == role {
+			members := make([]string, 0, len(b.Members))
+			for _, m := range b.Members {
+				if m != member {
+					members = append(members, m)
+				}
+			}
+			if len(members) == 0 {
+				continue
+			}
+			b.Members = members
+		}
+		result = append(result, b)
+	}
+	return result
+}
@@ -0,0 +1,192 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestSetIamBindingMember(t *testing.T) {
+	sa := "serviceAccount:123-compute@developer.gserviceaccount.com"
+
+	cases := map[string]struct {
+		Bindings []*cloudresourcemanager.Binding
+		Present  bool
+		Expected []*cloudresourcemanager.Binding
+	}{
+		"revoke": {
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{sa, "user:admin@example.com"}},
+				{Role: "roles/viewer", Members: []string{sa}},
+			},
+			Expected: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{"user:admin@example.com"}},
+				{Role: "roles/viewer", Members: []string{sa}},
+			},
+		},
+		"revoke last member": {
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{sa}},
+			},
+			Expected: []*cloudresourcemanager.Binding{},
+		},
+		"grant": {
+			Bindings: []*cloudresourcemanager.Binding{},
+			Present:  true,
+			Expected: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{sa}},
+			},
+		},
+		"grant existing": {
+			Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{sa}},
+			},
+			Present: true,
+			Expected: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{sa}},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		actual := setIamBindingMember(tc.Bindings, "roles/editor", sa, tc.Present)
+		if !reflect.DeepEqual(rolesToMembersMap(actual), rolesToMembersMap(tc.Expected)) {
+			t.Errorf("bad: %s, expected %v, got %v", tn, rolesToMembersMap(tc.Expected), rolesToMembersMap(actual))
+		}
+	}
+}
+
+func TestAccProjectDefaultServiceAccounts_deprivilege(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	billingId := getTestBillingAccountFromEnv(t)
+	pid := "terraform-" + acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccProjectDefaultServiceAccounts(pid, pname, org, billingId, "DEPRIVILEGE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_project_default_service_accounts.acceptance", "service_accounts.%", "1"),
+					testAccCheckProjectDefaultServiceAccountsEditor(pid, false),
+				),
+			},
+			// Destroying the resource restores the role, the project is kept
+			// to check it.
+			resource.TestStep{
+				Config: testAccProjectDefaultServiceAccounts_computeOnly(pid, pname, org, billingId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectDefaultServiceAccountsEditor(pid, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectDefaultServiceAccounts_disable(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	billingId := getTestBillingAccountFromEnv(t)
+	pid := "terraform-" + acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccProjectDefaultServiceAccounts(pid, pname, org, billingId, "DISABLE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_project_default_service_accounts.acceptance", "service_accounts.%", "1"),
+					testAccCheckProjectDefaultServiceAccountsDisabled(pid, true),
+				),
+			},
+			resource.TestStep{
+				Config: testAccProjectDefaultServiceAccounts_computeOnly(pid, pname, org, billingId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectDefaultServiceAccountsDisabled(pid, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectDefaultServiceAccountsEditor(pid string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		accounts, err := getProjectDefaultServiceAccounts(pid, config)
+		if err != nil {
+			return err
+		}
+
+		p, err := getProjectIamPolicy(pid, config)
+		if err != nil {
+			return err
+		}
+		members := rolesToMembersMap(p.Bindings)[defaultServiceAccountRole]
+
+		for _, sa := range accounts {
+			if members["serviceAccount:"+sa.Email] != expected {
+				return fmt.Errorf("Expected %s of %q to be %t", defaultServiceAccountRole, sa.Email, expected)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckProjectDefaultServiceAccountsDisabled(pid string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		accounts, err := getProjectDefaultServiceAccounts(pid, config)
+		if err != nil {
+			return err
+		}
+
+		for _, sa := range accounts {
+			res, err := sendRequest(config, "GET", iamBasePath+sa.Name, nil)
+			if err != nil {
+				return err
+			}
+			if disabled := res["disabled"] == true; disabled != expected {
+				return fmt.Errorf("Expected service account %q to have disabled %t, got %t", sa.Email, expected, disabled)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccProjectDefaultServiceAccounts(pid, name, org, billing, action string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "google_project_default_service_accounts" "acceptance" {
+  project = "${google_project_service.compute.project}"
+  action  = "%s"
+}
+`, testAccProjectDefaultServiceAccounts_computeOnly(pid, name, org, billing), action)
+}
+
+func testAccProjectDefaultServiceAccounts_computeOnly(pid, name, org, billing string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id      = "%s"
+  name            = "%s"
+  org_id          = "%s"
+  billing_account = "%s"
+  deletion_policy = "DELETE"
+}
+
+resource "google_project_service" "compute" {
+  project = "${google_project.acceptance.project_id}"
+  service = "compute.googleapis.com"
+}
+`, pid, name, org, billing)
+}
@@ -18,6 +18,8 @@ func resourceGoogleProjectIamCustomRole() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customRolePermissionsCustomizeDiff(projectCustomRoleFullResourceName),
+
 		Schema: map[string]*schema.Schema{
 			"role_id": {
 				Type:     schema.TypeString,
@@ -3,6 +3,7 @@ package google
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -71,6 +72,24 @@ func TestAccProjectIamCustomRole_undelete(t *testing.T) {
 	})
 }
 
+func TestAccProjectIamCustomRole_invalidPermissions(t *testing.T) {
+	t.Parallel()
+
+	roleId := "tfIamCustomRole" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGoogleProjectIamCustomRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckGoogleProjectIamCustomRole_invalidPermissions(roleId),
+				ExpectError: regexp.MustCompile(`"iam.roles.notARealPermission" is not a known permission`),
+			},
+		},
+	})
+}
+
 func testAccCheckGoogleProjectIamCustomRoleDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -194,3 +213,14 @@ resource "google_project_iam_custom_role" "foo" {
 }
 `, roleId)
 }
+
+func testAccCheckGoogleProjectIamCustomRole_invalidPermissions(roleId string) string {
+	return fmt.Sprintf(`
+resource "google_project_iam_custom_role" "foo" {
+  role_id     = "%s"
+  title       = "My Custom Role"
+  description = "foo"
+  permissions = ["iam.roles.list", "iam.roles.notARealPermission"]
+}
+`, roleId)
+}
@@ -18,7 +18,7 @@ func resourceGoogleProjectIamPolicy() *schema.Resource {
 		Update: resourceGoogleProjectIamPolicyUpdate,
 		Delete: resourceGoogleProjectIamPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceGoogleProjectIamPolicyImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -38,6 +38,11 @@ func resourceGoogleProjectIamPolicy() *schema.Resource {
 				Optional:   true,
 				Deprecated: "A future version of Terraform will remove the authoritative field. To ignore changes not managed by Terraform, use google_project_iam_binding and google_project_iam_member instead. See https://www.terraform.io/docs/providers/google/r/google_project_iam.html for more information.",
 			},
+			"strip_deleted_members": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"etag": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -55,6 +60,11 @@ func resourceGoogleProjectIamPolicy() *schema.Resource {
 	}
 }
 
+func resourceGoogleProjectIamPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("strip_deleted_members", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceGoogleProjectIamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	pid, err := getProject(d, config)
@@ -88,6 +98,7 @@ func resourceGoogleProjectIamPolicyCreate(d *schema.ResourceData, meta interface
 		if err != nil {
 			return err
 		}
+		stripProjectIamPolicyDeletedMembers(d, ep)
 
 		// First, subtract the policy defined in the template from the
 		// current policy in the project, and save the result. This will
@@ -124,6 +135,7 @@ func resourceGoogleProjectIamPolicyRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return err
 	}
+	stripProjectIamPolicyDeletedMembers(d, p)
 
 	var bindings []*cloudresourcemanager.Binding
 	if v, ok := d.GetOk("restore_policy"); ok {
@@ -194,6 +206,7 @@ func resourceGoogleProjectIamPolicyUpdate(d *schema.ResourceData, meta interface
 		if err != nil {
 			return fmt.Errorf("Error retrieving IAM policy from project API: %v", err)
 		}
+		stripProjectIamPolicyDeletedMembers(d, ep)
 		epBytes, _ := json.Marshal(ep)
 		log.Printf("[DEBUG] Got existing version of changed IAM policy from project API: %s", string(epBytes))
 
@@ -303,9 +316,16 @@ func getResourceIamPolicy(d *schema.ResourceData) (*cloudresourcemanager.Policy,
 	if err := json.Unmarshal([]byte(ps), policy); err != nil {
 		return nil, fmt.Errorf("Could not unmarshal %s:\n: %v", ps, err)
 	}
+	policy.Bindings = normalizeIamBindings(policy.Bindings, false)
 	return policy, nil
 }
 
+// Normalizes the members of a policy retrieved from the API, removing the
+// members of deleted principals if the resource is configured to do so.
+func stripProjectIamPolicyDeletedMembers(d *schema.ResourceData, policy *cloudresourcemanager.Policy) {
+	policy.Bindings = normalizeIamBindings(policy.Bindings, d.Get("strip_deleted_members").(bool))
+}
+
 // Get the previous cloudresourcemanager.Policy from a schema.ResourceData if the
 // resource has changed
 func getPrevResourceIamPolicy(d *schema.ResourceData) (*cloudresourcemanager.Policy, error) {
@@ -369,8 +389,8 @@ func jsonPolicyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 		log.Printf("[ERROR] Could not unmarshal new policy %s: %v", new, err)
 		return false
 	}
-	oldPolicy.Bindings = mergeBindings(oldPolicy.Bindings)
-	newPolicy.Bindings = mergeBindings(newPolicy.Bindings)
+	oldPolicy.Bindings = mergeBindings(normalizeIamBindings(oldPolicy.Bindings, false))
+	newPolicy.Bindings = mergeBindings(normalizeIamBindings(newPolicy.Bindings, false))
 	if newPolicy.Etag != oldPolicy.Etag {
 		return false
 	}
@@ -739,6 +739,7 @@ resource "google_project" "acceptance" {
     name = "%s"
     org_id = "%s"
     billing_account = "%s"
+    deletion_policy = "DELETE"
 }`, pid, name, org, billing)
 }
 
@@ -770,3 +771,32 @@ data "google_iam_policy" "expanded" {
     }
 }`, pid, name, org)
 }
+
+func TestJsonPolicyDiffSuppress_memberCasing(t *testing.T) {
+	cases := map[string]struct {
+		Old, New       string
+		ExpectSuppress bool
+	}{
+		"same members": {
+			Old:            `{"bindings":[{"role":"roles/viewer","members":["user:jane@example.com"]}]}`,
+			New:            `{"bindings":[{"role":"roles/viewer","members":["user:jane@example.com"]}]}`,
+			ExpectSuppress: true,
+		},
+		"different casing": {
+			Old:            `{"bindings":[{"role":"roles/viewer","members":["serviceAccount:sa@my-project.iam.gserviceaccount.com"]}]}`,
+			New:            `{"bindings":[{"role":"roles/viewer","members":["serviceaccount:SA@my-project.iam.gserviceaccount.com"]}]}`,
+			ExpectSuppress: true,
+		},
+		"different members": {
+			Old:            `{"bindings":[{"role":"roles/viewer","members":["user:jane@example.com"]}]}`,
+			New:            `{"bindings":[{"role":"roles/viewer","members":["user:john@example.com"]}]}`,
+			ExpectSuppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if jsonPolicyDiffSuppress("policy_data", tc.Old, tc.New, nil) != tc.ExpectSuppress {
+			t.Errorf("bad: %s, %q => %q expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectSuppress)
+		}
+	}
+}
@@ -51,7 +51,8 @@ func resourceGoogleProjectServiceCreate(d *schema.ResourceData, meta interface{}
 
 	srv := d.Get("service").(string)
 
-	if err = enableService(srv, project, config); err != nil {
+	// Services of the same project created together are enabled in batches
+	if err = batchEnable(srv, project, config); err != nil {
 		return errwrap.Wrapf("Error enabling service: {{err}}", err)
 	}
 
@@ -100,6 +101,22 @@ func resourceGoogleProjectServiceDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	// Disabling a service other enabled services depend on would implicitly
+	// turn them off as well, refuse to do so.
+	services, err := getApiServices(id.project, config, map[string]struct{}{})
+	if err != nil {
+		return err
+	}
+
+	graph, err := getServiceDependencyGraph(id.project, services, config)
+	if err != nil {
+		return err
+	}
+
+	if dependents := graph.dependents(id.service, services); len(dependents) > 0 {
+		return fmt.Errorf("Error disabling service %q for project %q: it is required by the enabled service(s) %q. Disable them first, or set disable_on_destroy to false.", id.service, id.project, dependents)
+	}
+
 	if err = disableService(id.service, id.project, config); err != nil {
 		return fmt.Errorf("Error disabling service: %s", err)
 	}
@@ -22,6 +22,8 @@ func resourceGoogleProjectServices() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceGoogleProjectServicesCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
@@ -40,6 +42,12 @@ func resourceGoogleProjectServices() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"dependency_services": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -84,13 +92,38 @@ func resourceGoogleProjectServicesCreate(d *schema.ResourceData, meta interface{
 func resourceGoogleProjectServicesRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	services, err := getApiServices(d.Id(), config, ignoreProjectServices)
+	apiServices, err := getApiServices(d.Id(), config, ignoreProjectServices)
 	if err != nil {
 		return err
 	}
 
+	// Services that were only enabled because a listed service depends on
+	// them are reported separately, so they don't show up as a diff. When
+	// importing nothing is listed yet and every enabled service is kept.
+	known := getConfigServices(d)
+	graph, err := getServiceDependencyGraph(d.Id(), known, config)
+	if err != nil {
+		return err
+	}
+
+	required := make(map[string]struct{})
+	for _, s := range graph.requiredBy(known) {
+		required[s] = struct{}{}
+	}
+
+	services := make([]string, 0, len(apiServices))
+	dependencies := make([]string, 0)
+	for _, s := range apiServices {
+		if _, ok := required[s]; ok {
+			dependencies = append(dependencies, s)
+		} else {
+			services = append(services, s)
+		}
+	}
+
 	d.Set("project", d.Id())
 	d.Set("services", services)
+	d.Set("dependency_services", dependencies)
 	return nil
 }
 
@@ -127,7 +160,18 @@ func resourceGoogleProjectServicesDelete(d *schema.ResourceData, meta interface{
 	}
 
 	config := meta.(*Config)
+	// Services only enabled as dependencies of the listed services are
+	// disabled too, after the services depending on them.
 	services := resourceServices(d)
+	for _, s := range d.Get("dependency_services").(*schema.Set).List() {
+		services = append(services, s.(string))
+	}
+	graph, err := getServiceDependencyGraph(d.Id(), services, config)
+	if err != nil {
+		log.Printf("[WARN] Unable to order services by their dependencies: %s", err)
+	} else {
+		services = graph.disableOrder(services)
+	}
 	for _, s := range services {
 		disableService(s, d.Id(), config)
 	}
@@ -135,9 +179,43 @@ func resourceGoogleProjectServicesDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+func resourceGoogleProjectServicesCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("services") {
+		return nil
+	}
+
+	// The project may not exist yet, in which case its dependencies can only
+	// be known after apply.
+	pid := diff.Get("project").(string)
+	if diff.Id() == "" || pid == "" || !diff.NewValueKnown("project") || !diff.NewValueKnown("services") {
+		return diff.SetNewComputed("dependency_services")
+	}
+
+	config := meta.(*Config)
+	services := make([]string, 0)
+	for _, s := range diff.Get("services").(*schema.Set).List() {
+		services = append(services, s.(string))
+	}
+
+	graph, err := getServiceDependencyGraph(pid, services, config)
+	if err != nil {
+		return err
+	}
+
+	// Dependencies that drop out of dependency_services are disabled along
+	// with the listed services, the plan shows them as removed.
+	dependencies := make([]string, 0)
+	for _, s := range graph.requiredBy(services) {
+		if _, ok := ignoreProjectServices[s]; !ok {
+			dependencies = append(dependencies, s)
+		}
+	}
+	return diff.SetNew("dependency_services", dependencies)
+}
+
 // This function ensures that the services enabled for a project exactly match that
 // in a config by disabling any services that are returned by the API but not present
-// in the config
+// in the config. Services that configured services depend on are kept enabled.
 func reconcileServices(cfgServices, apiServices []string, config *Config, pid string) error {
 	// Helper to convert slice to map
 	m := func(vals []string) map[string]struct{} {
@@ -148,20 +226,36 @@ func reconcileServices(cfgServices, apiServices []string, config *Config, pid st
 		return sm
 	}
 
+	graph, err := getServiceDependencyGraph(pid, cfgServices, config)
+	if err != nil {
+		return err
+	}
+
 	cfgMap := m(cfgServices)
 	apiMap := m(apiServices)
+	requiredMap := m(graph.requiredBy(cfgServices))
 
+	disable := make([]string, 0)
 	for k, _ := range apiMap {
-		if _, ok := cfgMap[k]; !ok {
-			// The service in the API is not in the config; disable it.
-			err := disableService(k, pid, config)
-			if err != nil {
-				return err
-			}
-		} else {
+		if _, ok := cfgMap[k]; ok {
 			// The service exists in the config and the API, so we don't need
 			// to re-enable it
 			delete(cfgMap, k)
+		} else if _, ok := requiredMap[k]; !ok {
+			// The service in the API is not in the config and no service in
+			// the config depends on it; disable it.
+			disable = append(disable, k)
+		}
+	}
+
+	disableGraph, err := getServiceDependencyGraph(pid, disable, config)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range disableGraph.disableOrder(disable) {
+		if err := disableService(k, pid, config); err != nil {
+			return err
 		}
 	}
 
@@ -169,7 +263,7 @@ func reconcileServices(cfgServices, apiServices []string, config *Config, pid st
 	for k, _ := range cfgMap {
 		keys = append(keys, k)
 	}
-	err := enableServices(keys, pid, config)
+	err = enableServices(keys, pid, config)
 	if err != nil {
 		return err
 	}
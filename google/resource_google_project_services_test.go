@@ -178,6 +178,39 @@ func TestAccProjectServices_ignoreUnenablableServices(t *testing.T) {
 	})
 }
 
+// Test that services enabled as dependencies of listed services are neither
+// disabled nor cause diffs, and that they're disabled once nothing listed
+// depends on them anymore.
+func TestAccProjectServices_dependencies(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	billingId := getTestBillingAccountFromEnv(t)
+	pid := "terraform-" + acctest.RandString(10)
+	services1 := []string{"container.googleapis.com"}
+	services2 := []string{"iam.googleapis.com"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccProjectAssociateServicesBasic_withBilling(services1, pid, pname, org, billingId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectService([]string{"container.googleapis.com", "compute.googleapis.com"}, pid, true),
+					resource.TestCheckResourceAttrSet("google_project_services.acceptance", "dependency_services.#"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccProjectAssociateServicesBasic_withBilling(services2, pid, pname, org, billingId),
+				Check: resource.ComposeTestCheckFunc(
+					testProjectServicesMatch(services2, pid),
+				),
+			},
+		},
+	})
+}
+
 func TestAccProjectServices_pagination(t *testing.T) {
 	t.Parallel()
 
@@ -280,6 +313,7 @@ resource "google_project" "acceptance" {
   name            = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 resource "google_project_services" "acceptance" {
   project            = "${google_project.acceptance.project_id}"
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -89,9 +90,10 @@ func TestAccProject_billing(t *testing.T) {
 			},
 			// Make sure import supports billing account
 			resource.TestStep{
-				ResourceName:      "google_project.acceptance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_project.acceptance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_policy"},
 			},
 			// Update to a different  billing account
 			resource.TestStep{
@@ -170,6 +172,70 @@ func TestAccProject_deleteDefaultNetwork(t *testing.T) {
 	})
 }
 
+func TestAccProject_deletionPolicy(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	pid := "terraform-" + acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProject_deletionPolicy(pid, pname, org, "PREVENT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_project.acceptance", "deletion_lien"),
+					testAccCheckGoogleProjectHasDeletionLien(pid, true),
+				),
+			},
+			// Replacing the project is refused at plan time
+			{
+				Config:      testAccProject_deletionPolicy("terraform-"+acctest.RandString(10), pname, org, "PREVENT"),
+				ExpectError: regexp.MustCompile("deletion_policy is PREVENT"),
+			},
+			{
+				Config: testAccProject_deletionPolicy(pid, pname, org, "DELETE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_project.acceptance", "deletion_lien", ""),
+					testAccCheckGoogleProjectHasDeletionLien(pid, false),
+				),
+			},
+			{
+				ResourceName:            "google_project.acceptance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_policy"},
+			},
+		},
+	})
+}
+
+func TestAccProject_deletionPolicyBilled(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	billingId := getTestBillingAccountFromEnv(t)
+	pid := acctest.RandomWithPrefix("tf-test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProject_deletionPolicyBilled(pid, org, billingId, ""),
+			},
+			// Replacing a billed project with enabled services needs an
+			// explicit deletion_policy
+			{
+				Config:      testAccProject_deletionPolicyBilled(acctest.RandomWithPrefix("tf-test"), org, billingId, ""),
+				ExpectError: regexp.MustCompile("Set deletion_policy to DELETE"),
+			},
+			{
+				Config: testAccProject_deletionPolicyBilled(pid, org, billingId, "DELETE"),
+			},
+		},
+	})
+}
+
 func TestAccProject_parentFolder(t *testing.T) {
 	t.Parallel()
 
@@ -236,9 +302,10 @@ func TestAccProject_appEngineBasicWithBilling(t *testing.T) {
 				),
 			},
 			resource.TestStep{
-				ResourceName:      "google_project.acceptance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_project.acceptance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_policy"},
 			},
 		},
 	})
@@ -360,6 +427,25 @@ func testAccCheckGoogleProjectHasBillingAccount(r, pid, billingId string) resour
 	}
 }
 
+func testAccCheckGoogleProjectHasDeletionLien(pid string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		lien, err := getProjectDeletionLien(pid, config)
+		if err != nil {
+			return err
+		}
+
+		if expected && lien == "" {
+			return fmt.Errorf("Expected project %q to have a deletion lien", pid)
+		}
+		if !expected && lien != "" {
+			return fmt.Errorf("Expected project %q to have no deletion lien, found %q", pid, lien)
+		}
+		return nil
+	}
+}
+
 func testAccCheckGoogleProjectHasLabels(r, pid string, expected map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[r]
@@ -455,10 +541,41 @@ resource "google_project" "acceptance" {
   name                = "%s"
   org_id              = "%s"
   billing_account     = "%s"  # requires billing to enable compute API
+  deletion_policy     = "DELETE"
   auto_create_network = false
 }`, pid, name, org, billing)
 }
 
+func testAccProject_deletionPolicy(pid, name, org, policy string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id      = "%s"
+  name            = "%s"
+  org_id          = "%s"
+  deletion_policy = "%s"
+}`, pid, name, org, policy)
+}
+
+func testAccProject_deletionPolicyBilled(pid, org, billing, policy string) string {
+	deletionPolicy := ""
+	if policy != "" {
+		deletionPolicy = fmt.Sprintf("deletion_policy = %q", policy)
+	}
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id      = "%s"
+  name            = "%s"
+  org_id          = "%s"
+  billing_account = "%s"
+  %s
+}
+
+resource "google_project_service" "acceptance" {
+  project = "${google_project.acceptance.project_id}"
+  service = "pubsub.googleapis.com"
+}`, pid, pid, org, billing, deletionPolicy)
+}
+
 func testAccProject_parentFolder(pid, projectName, folderName, org string) string {
 	return fmt.Sprintf(`
 resource "google_project" "acceptance" {
@@ -509,6 +626,7 @@ resource "google_project" "acceptance" {
   org_id     = "%s"
 
   billing_account = "%s"
+  deletion_policy = "DELETE"
 
   app_engine {
     auth_domain    = "hashicorptest.com"
@@ -7,9 +7,12 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/iam/v1"
 )
 
+const iamBasePath = "https://iam.googleapis.com/v1/"
+
 func resourceGoogleServiceAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGoogleServiceAccountCreate,
@@ -17,7 +20,7 @@ func resourceGoogleServiceAccount() *schema.Resource {
 		Delete: resourceGoogleServiceAccountDelete,
 		Update: resourceGoogleServiceAccountUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceGoogleServiceAccountImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"email": &schema.Schema{
@@ -42,6 +45,21 @@ func resourceGoogleServiceAccount() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"disabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"disable_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"project": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -64,23 +82,33 @@ func resourceGoogleServiceAccountCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 	aid := d.Get("account_id").(string)
-	displayName := d.Get("display_name").(string)
-
-	sa := &iam.ServiceAccount{
-		DisplayName: displayName,
-	}
 
-	r := &iam.CreateServiceAccountRequest{
-		AccountId:      aid,
-		ServiceAccount: sa,
+	// The vendored IAM client predates descriptions, so the request is built
+	// by hand.
+	r := map[string]interface{}{
+		"accountId": aid,
+		"serviceAccount": map[string]interface{}{
+			"displayName": d.Get("display_name").(string),
+			"description": d.Get("description").(string),
+		},
 	}
 
-	sa, err = config.clientIAM.Projects.ServiceAccounts.Create("projects/"+project, r).Do()
+	res, err := sendRequest(config, "POST", iamBasePath+"projects/"+project+"/serviceAccounts", r)
 	if err != nil {
 		return fmt.Errorf("Error creating service account: %s", err)
 	}
 
-	d.SetId(sa.Name)
+	name, _ := res["name"].(string)
+	if name == "" {
+		return fmt.Errorf("Error creating service account: no name in response")
+	}
+	d.SetId(name)
+
+	if d.Get("disabled").(bool) {
+		if err := setServiceAccountDisabled(config, name, true); err != nil {
+			return err
+		}
+	}
 
 	// Apply the IAM policy if it is set
 	if pString, ok := d.GetOk("policy_data"); ok {
@@ -94,9 +122,9 @@ func resourceGoogleServiceAccountCreate(d *schema.ResourceData, meta interface{}
 		// Retrieve existing IAM policy from project. This will be merged
 		// with the policy defined here.
 		// TODO: overwrite existing policy, instead of merging it
-		p, err := getServiceAccountIamPolicy(sa.Name, config)
+		p, err := getServiceAccountIamPolicy(name, config)
 		if err != nil {
-			return fmt.Errorf("Could not find service account %q when applying IAM policy: %s", sa.Name, err)
+			return fmt.Errorf("Could not find service account %q when applying IAM policy: %s", name, err)
 		}
 		log.Printf("[DEBUG] Got existing bindings for service account: %#v", p.Bindings)
 
@@ -105,11 +133,11 @@ func resourceGoogleServiceAccountCreate(d *schema.ResourceData, meta interface{}
 
 		// Apply the merged policy
 		log.Printf("[DEBUG] Setting new policy for service account: %#v", p)
-		_, err = config.clientIAM.Projects.ServiceAccounts.SetIamPolicy(sa.Name,
+		_, err = config.clientIAM.Projects.ServiceAccounts.SetIamPolicy(name,
 			&iam.SetIamPolicyRequest{Policy: p}).Do()
 
 		if err != nil {
-			return fmt.Errorf("Error applying IAM policy for service account %q: %s", sa.Name, err)
+			return fmt.Errorf("Error applying IAM policy for service account %q: %s", name, err)
 		}
 	}
 	return resourceGoogleServiceAccountRead(d, meta)
@@ -118,24 +146,38 @@ func resourceGoogleServiceAccountCreate(d *schema.ResourceData, meta interface{}
 func resourceGoogleServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	// Confirm the service account exists
-	sa, err := config.clientIAM.Projects.ServiceAccounts.Get(d.Id()).Do()
+	// Confirm the service account exists. As with create, the vendored client
+	// would drop the description and disabled fields.
+	sa, err := sendRequest(config, "GET", iamBasePath+d.Id(), nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Service Account %q", d.Id()))
 	}
 
-	d.Set("email", sa.Email)
-	d.Set("unique_id", sa.UniqueId)
-	d.Set("project", sa.ProjectId)
-	d.Set("account_id", strings.Split(sa.Email, "@")[0])
-	d.Set("name", sa.Name)
-	d.Set("display_name", sa.DisplayName)
+	email, _ := sa["email"].(string)
+	d.Set("email", email)
+	d.Set("unique_id", sa["uniqueId"])
+	d.Set("project", sa["projectId"])
+	d.Set("account_id", strings.Split(email, "@")[0])
+	d.Set("name", sa["name"])
+	d.Set("display_name", sa["displayName"])
+	d.Set("description", sa["description"])
+	d.Set("disabled", sa["disabled"] == true)
 	return nil
 }
 
 func resourceGoogleServiceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	name := d.Id()
+
+	if d.Get("disable_on_destroy").(bool) {
+		log.Printf("[DEBUG] Disabling service account %q instead of deleting it", name)
+		if err := setServiceAccountDisabled(config, name, true); err != nil {
+			return err
+		}
+		d.SetId("")
+		return nil
+	}
+
 	_, err := config.clientIAM.Projects.ServiceAccounts.Delete(name).Do()
 	if err != nil {
 		return err
@@ -147,21 +189,25 @@ func resourceGoogleServiceAccountDelete(d *schema.ResourceData, meta interface{}
 func resourceGoogleServiceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	var err error
-	if ok := d.HasChange("display_name"); ok {
-		sa, err := config.clientIAM.Projects.ServiceAccounts.Get(d.Id()).Do()
-		if err != nil {
-			return fmt.Errorf("Error retrieving service account %q: %s", d.Id(), err)
-		}
-		_, err = config.clientIAM.Projects.ServiceAccounts.Update(d.Id(),
-			&iam.ServiceAccount{
-				DisplayName: d.Get("display_name").(string),
-				Etag:        sa.Etag,
-			}).Do()
+	if d.HasChange("display_name") || d.HasChange("description") {
+		_, err = sendRequest(config, "PATCH", iamBasePath+d.Id(), map[string]interface{}{
+			"serviceAccount": map[string]interface{}{
+				"displayName": d.Get("display_name").(string),
+				"description": d.Get("description").(string),
+			},
+			"updateMask": "displayName,description",
+		})
 		if err != nil {
 			return fmt.Errorf("Error updating service account %q: %s", d.Id(), err)
 		}
 	}
 
+	if d.HasChange("disabled") {
+		if err = setServiceAccountDisabled(config, d.Id(), d.Get("disabled").(bool)); err != nil {
+			return err
+		}
+	}
+
 	if ok := d.HasChange("policy_data"); ok {
 		// The policy string is just a marshaled cloudresourcemanager.Policy.
 		// Unmarshal it to a struct that contains the old and new policies
@@ -254,6 +300,28 @@ func resourceGoogleServiceAccountUpdate(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func resourceGoogleServiceAccountImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Explicitly set to default as a workaround for `ImportStateVerify` tests.
+	d.Set("disable_on_destroy", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// setServiceAccountDisabled disables or re-enables a service account. A
+// disabled account keeps its unique id and IAM bindings, but can no longer
+// authenticate.
+func setServiceAccountDisabled(config *Config, name string, disabled bool) error {
+	action := "enable"
+	if disabled {
+		action = "disable"
+	}
+
+	_, err := sendRequest(config, "POST", iamBasePath+name+":"+action, nil)
+	if err != nil {
+		return fmt.Errorf("Error trying to %s service account %q: %s", action, name, err)
+	}
+	return nil
+}
+
 // Retrieve the existing IAM Policy for a service account
 func getServiceAccountIamPolicy(sa string, config *Config) (*iam.Policy, error) {
 	p, err := config.clientIAM.Projects.ServiceAccounts.GetIamPolicy(sa).Do()
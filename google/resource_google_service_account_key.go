@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/encryption"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,7 +14,11 @@ func resourceGoogleServiceAccountKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGoogleServiceAccountKeyCreate,
 		Read:   resourceGoogleServiceAccountKeyRead,
+		Update: resourceGoogleServiceAccountKeyUpdate,
 		Delete: resourceGoogleServiceAccountKeyDelete,
+
+		CustomizeDiff: resourceGoogleServiceAccountKeyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			// Required
 			"service_account_id": &schema.Schema{
@@ -48,6 +53,23 @@ func resourceGoogleServiceAccountKey() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"TYPE_NONE", "TYPE_X509_PEM_FILE", "TYPE_RAW_PUBLIC_KEY"}, false),
 			},
+			"public_key_data": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"key_algorithm", "pgp_key", "private_key_type"},
+			},
+			"keepers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rotation_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			// Computed
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -92,6 +114,10 @@ func resourceGoogleServiceAccountKeyCreate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	if v, ok := d.GetOk("public_key_data"); ok {
+		return resourceGoogleServiceAccountKeyUpload(d, meta, serviceAccountName, v.(string))
+	}
+
 	r := &iam.CreateServiceAccountKeyRequest{
 		KeyAlgorithm:   d.Get("key_algorithm").(string),
 		PrivateKeyType: d.Get("private_key_type").(string),
@@ -130,6 +156,36 @@ func resourceGoogleServiceAccountKeyCreate(d *schema.ResourceData, meta interfac
 	return resourceGoogleServiceAccountKeyRead(d, meta)
 }
 
+// resourceGoogleServiceAccountKeyUpload registers a caller-supplied public key
+// with the service account. Google never sees the private key, so none of the
+// private key attributes are set.
+func resourceGoogleServiceAccountKeyUpload(d *schema.ResourceData, meta interface{}, serviceAccountName, publicKeyData string) error {
+	config := meta.(*Config)
+
+	url := iamBasePath + serviceAccountName + "/keys:upload"
+	res, err := sendRequest(config, "POST", url, map[string]interface{}{
+		"publicKeyData": publicKeyData,
+	})
+	if err != nil {
+		return fmt.Errorf("Error uploading service account key: %s", err)
+	}
+
+	name, ok := res["name"].(string)
+	if !ok || name == "" {
+		return fmt.Errorf("Error uploading service account key: no key name in response")
+	}
+
+	d.SetId(name)
+	d.Set("valid_after", res["validAfterTime"])
+	d.Set("valid_before", res["validBeforeTime"])
+
+	err = serviceAccountKeyWaitTime(config.clientIAM.Projects.ServiceAccounts.Keys, d.Id(), d.Get("public_key_type").(string), "Uploading Service account key", 4)
+	if err != nil {
+		return err
+	}
+	return resourceGoogleServiceAccountKeyRead(d, meta)
+}
+
 func resourceGoogleServiceAccountKeyRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -142,11 +198,18 @@ func resourceGoogleServiceAccountKeyRead(d *schema.ResourceData, meta interface{
 	}
 
 	d.Set("name", sak.Name)
-	d.Set("key_algorithm", sak.KeyAlgorithm)
+	if _, ok := d.GetOk("public_key_data"); !ok {
+		d.Set("key_algorithm", sak.KeyAlgorithm)
+	}
 	d.Set("public_key", sak.PublicKeyData)
 	return nil
 }
 
+// Only rotation_days can be updated in place, and it is only used at plan time.
+func resourceGoogleServiceAccountKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceGoogleServiceAccountKeyRead(d, meta)
+}
+
 func resourceGoogleServiceAccountKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -158,3 +221,40 @@ func resourceGoogleServiceAccountKeyDelete(d *schema.ResourceData, meta interfac
 	d.SetId("")
 	return nil
 }
+
+// resourceGoogleServiceAccountKeyCustomizeDiff plans a replacement of the key
+// once it is older than rotation_days.
+func resourceGoogleServiceAccountKeyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	rotationDays := diff.Get("rotation_days").(int)
+	validAfter, _ := diff.GetChange("valid_after")
+
+	expired, err := serviceAccountKeyRotationDue(validAfter.(string), rotationDays, time.Now())
+	if err != nil || !expired {
+		return err
+	}
+
+	if err := diff.SetNewComputed("valid_after"); err != nil {
+		return err
+	}
+	return diff.ForceNew("valid_after")
+}
+
+// serviceAccountKeyRotationDue returns whether a key valid since validAfter
+// has outlived a rotation window of rotationDays at the given time. Keys
+// without a rotation window or a known creation time are never due.
+func serviceAccountKeyRotationDue(validAfter string, rotationDays int, now time.Time) (bool, error) {
+	if rotationDays <= 0 || validAfter == "" {
+		return false, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, validAfter)
+	if err != nil {
+		return false, fmt.Errorf("Error parsing valid_after %q: %s", validAfter, err)
+	}
+
+	return !now.Before(t.Add(time.Duration(rotationDays) * 24 * time.Hour)), nil
+}
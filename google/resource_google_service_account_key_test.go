@@ -3,6 +3,7 @@ package google
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -80,6 +81,143 @@ func TestAccServiceAccountKey_pgp(t *testing.T) {
 	})
 }
 
+func TestAccServiceAccountKey_upload(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "google_service_account_key.acceptance"
+	accountID := "a" + acctest.RandString(10)
+	displayName := "Terraform Test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccServiceAccountKey_upload(accountID, displayName, testServiceAccountKeyPublicCert),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleServiceAccountKeyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "valid_after"),
+					resource.TestCheckResourceAttr(resourceName, "private_key", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceAccountKey_keepers(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "google_service_account_key.acceptance"
+	accountID := "a" + acctest.RandString(10)
+	displayName := "Terraform Test"
+	var firstKey string
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccServiceAccountKey_keepers(accountID, displayName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleServiceAccountKeyExists(resourceName),
+					testAccCheckGoogleServiceAccountKeyId(resourceName, &firstKey),
+				),
+			},
+			resource.TestStep{
+				Config: testAccServiceAccountKey_keepers(accountID, displayName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleServiceAccountKeyExists(resourceName),
+					testAccCheckGoogleServiceAccountKeyReplaced(resourceName, &firstKey),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceAccountKeyRotationDue(t *testing.T) {
+	now := time.Date(2018, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		ValidAfter   string
+		RotationDays int
+		Expected     bool
+		ExpectError  bool
+	}{
+		"no rotation": {
+			ValidAfter:   "2017-01-01T00:00:00Z",
+			RotationDays: 0,
+			Expected:     false,
+		},
+		"unknown creation time": {
+			ValidAfter:   "",
+			RotationDays: 30,
+			Expected:     false,
+		},
+		"within window": {
+			ValidAfter:   "2018-06-15T00:00:00Z",
+			RotationDays: 30,
+			Expected:     false,
+		},
+		"exactly at window": {
+			ValidAfter:   "2018-06-01T00:00:00Z",
+			RotationDays: 30,
+			Expected:     true,
+		},
+		"past window": {
+			ValidAfter:   "2018-03-01T12:30:00.123456789Z",
+			RotationDays: 90,
+			Expected:     true,
+		},
+		"invalid time": {
+			ValidAfter:   "yesterday",
+			RotationDays: 90,
+			ExpectError:  true,
+		},
+	}
+
+	for tn, tc := range cases {
+		due, err := serviceAccountKeyRotationDue(tc.ValidAfter, tc.RotationDays, now)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if due != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, due)
+		}
+	}
+}
+
+func testAccCheckGoogleServiceAccountKeyId(r string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("Not found: %s", r)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckGoogleServiceAccountKeyReplaced(r string, previous *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("Not found: %s", r)
+		}
+
+		if rs.Primary.ID == *previous {
+			return fmt.Errorf("Expected service account key %s to be replaced", *previous)
+		}
+		return nil
+	}
+}
+
 func testAccCheckGoogleServiceAccountKeyExists(r string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -130,6 +268,42 @@ resource "google_service_account_key" "acceptance" {
 `, account, name)
 }
 
+func testAccServiceAccountKey_upload(account, name, publicKeyData string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "acceptance" {
+	account_id = "%s"
+	display_name = "%s"
+}
+
+resource "google_service_account_key" "acceptance" {
+	service_account_id = "${google_service_account.acceptance.name}"
+	public_key_data = "%s"
+}
+`, account, name, publicKeyData)
+}
+
+func testAccServiceAccountKey_keepers(account, name, keeper string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "acceptance" {
+	account_id = "%s"
+	display_name = "%s"
+}
+
+resource "google_service_account_key" "acceptance" {
+	service_account_id = "${google_service_account.acceptance.name}"
+	rotation_days = 90
+
+	keepers {
+		rotation = "%s"
+	}
+
+	lifecycle {
+		create_before_destroy = true
+	}
+}
+`, account, name, keeper)
+}
+
 func testAccServiceAccountKey_pgp(account, name string, key string) string {
 	return fmt.Sprintf(`
 resource "google_service_account" "acceptance" {
@@ -173,3 +347,7 @@ PXFv3m3WfUlnG/om78UjQqyVACRZqqAGmuPq+TSkRUCpt9h+A39LQWkojHqyob3cyLgy6z9Q557O
 9uK3lQozbw2gH9zC0RqnePl+rsWIUU/ga16fH6pWc1uJiEBt8UZGypQ/E56/343epmYAe0a87sHx
 8iDV+dNtDVKfPRENiLOOc19MmS+phmUyrbHqI91c0pmysYcJZCD3a502X1gpjFbPZcRtiTmGnUKd
 OIu60YPNE4+h7u2CfYyFPu3AlUaGNMBlvy6PEpU=`
+
+// A self-signed X.509 certificate, base64 encoded, whose private key has been
+// discarded.
+const testServiceAccountKeyPublicCert = "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSURCVENDQWUyZ0F3SUJBZ0lVVzhCOWhvYXYwWGplaWpEQkV4U3JIdEtXQkR3d0RRWUpLb1pJaHZjTkFRRUwKQlFBd0VURVBNQTBHQTFVRUF3d0dkVzUxYzJWa01DQVhEVEkyTVRBeE9URTJNVGcxTTFvWUR6SXhNall3T1RJMQpNVFl4T0RVeldqQVJNUTh3RFFZRFZRUUREQVoxYm5WelpXUXdnZ0VpTUEwR0NTcUdTSWIzRFFFQkFRVUFBNElCCkR3QXdnZ0VLQW9JQkFRQ0NSNVZUOERMOGtiTHhVcGh2cDRGNmdlNnk2cXpFQVZndG9Cb0FUUEJLeDgra2dVVE8KSW5hUlgzY0ZzQWp2bi8xY1hpbGpSdVFDM3lRRmcwY3pnQnN5VGd5T0ptQVBxU1E3MDdVQVFMclZSTzFueEM3eApXYzJqUk9XT2lwRWNxWTZ4bzlaeGtzaGdQUXNlMzduRWlHM0R2Rzc1M1dLQnFoOFVDTTZpR0RoUkx4c3JrYTc2Ckdzd1J5K1VZNHhZaldpQjVxZGtyb1Q2N1Y5QUVZd3BOWjRwS2RyTDVlUCtvc1FBL0tlemhZdjVORlFzVkppbDkKdG9DVW5RdmtyMXIzUjZKRTQ5VitOeUJzVkROUFh3ZSs2MnMxWWZCdkh5d1J5b3kzbHIrNTVPMnd1Sm9FbFB4VApXNWFJUEFxYW5VMnFlRXI5eXFjbXBhUkVJRExxQ2FNbGdHYUhBZ01CQUFHalV6QlJNQjBHQTFVZERnUVdCQlJECjM5Z0lSQ1hKbTFWdDdwRVJ4L1BVaE5rT3NqQWZCZ05WSFNNRUdEQVdnQlJEMzlnSVJDWEptMVZ0N3BFUngvUFUKaE5rT3NqQVBCZ05WSFJNQkFmOEVCVEFEQVFIL01BMEdDU3FHU0liM0RRRUJDd1VBQTRJQkFRQnY3WHBIZkdYSgpRQnpYNWx1T1hGREt0WDRKdWU2Z3JXOExEUEJKUWZ5S0JLTnkrb1l0Zys0dnhucDMvRmhJa0pCQkJMc0JyWER5CjdRdnpHc2kwVmNTRWhiY29FMEdUMlRJd1hwc1Z1cFJYcUlzREh0c09mbUxnSG9OOGJmMktxWDBQaysrL0RTeHAKYktLN1ZMdHV0SjhUNU9odXBXRll6LysxbDk2NnFuemJFempRc1pLR2p4K0hWT2pROUZVMWFOdEkzMUtETFlaOApHTHQ1c250KzBGbHp0WlhRZllFNkcvaURHYWN6NUtiY2hKWWUwbjh5K3NCTXFWSzdSemVUdnQ4RDA1M0plNTlKCmFiUS8rQzI4WFNFM3Q0Qis5ZzNzbC85OGsveUx6QzRpZmIybXFoZ2RBYzJ1V1daL0l4U3pPR0wrbkJ4ZFRiWmkKMnRWRzJLR3dsUExFCi0tLS0tRU5EIENFUlRJRklDQVRFLS0tLS0K"
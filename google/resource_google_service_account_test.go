@@ -93,6 +93,42 @@ func TestAccServiceAccount_createPolicy(t *testing.T) {
 	})
 }
 
+// Test that a service account can be disabled and re-enabled, and its
+// description updated, without being recreated.
+func TestAccServiceAccount_disabled(t *testing.T) {
+	t.Parallel()
+
+	accountId := "a" + acctest.RandString(10)
+	uniqueId := ""
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccServiceAccountDisabled(accountId, "break-glass account", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_service_account.acceptance", "disabled", "true"),
+					resource.TestCheckResourceAttr("google_service_account.acceptance", "description", "break-glass account"),
+					testAccStoreServiceAccountUniqueId(&uniqueId),
+				),
+			},
+			resource.TestStep{
+				Config: testAccServiceAccountDisabled(accountId, "enabled break-glass account", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_service_account.acceptance", "disabled", "false"),
+					resource.TestCheckResourceAttr("google_service_account.acceptance", "description", "enabled break-glass account"),
+					resource.TestCheckResourceAttrPtr("google_service_account.acceptance", "unique_id", &uniqueId),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "google_service_account.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccStoreServiceAccountUniqueId(uniqueId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		*uniqueId = s.RootModule().Resources["google_service_account.acceptance"].Primary.Attributes["unique_id"]
@@ -152,6 +188,15 @@ func testAccServiceAccountBasic(account, name string) string {
 	return fmt.Sprintf(t, account, name)
 }
 
+func testAccServiceAccountDisabled(account, description string, disabled bool) string {
+	t := `resource "google_service_account" "acceptance" {
+    account_id = "%v"
+    description = "%v"
+    disabled = %t
+ }`
+	return fmt.Sprintf(t, account, description, disabled)
+}
+
 func testAccServiceAccountWithProject(project, account, name string) string {
 	t := `resource "google_service_account" "acceptance" {
     project = "%v"
@@ -20,8 +20,15 @@ var iamBindingSchema = map[string]*schema.Schema{
 		Type:     schema.TypeSet,
 		Required: true,
 		Elem: &schema.Schema{
-			Type: schema.TypeString,
+			Type:      schema.TypeString,
+			StateFunc: iamMemberStateFunc,
 		},
+		Set: hashIamMember,
+	},
+	"strip_deleted_members": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
 	},
 	"etag": {
 		Type:     schema.TypeString,
@@ -61,6 +68,9 @@ func resourceIamBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.C
 			// This prevents removing existing permission without the user's knowledge.
 			// Instead, a diff is shown in that case after creation. Subsequent calls to update will remove any
 			// existing members not present in the provided list.
+			if d.Get("strip_deleted_members").(bool) {
+				ep.Bindings = stripDeletedIamMembersFromRole(ep.Bindings, p.Role)
+			}
 			ep.Bindings = mergeBindings(append(ep.Bindings, p))
 			return nil
 		})
@@ -107,7 +117,7 @@ func resourceIamBindingRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Rea
 			return nil
 		}
 		d.Set("etag", p.Etag)
-		d.Set("members", binding.Members)
+		d.Set("members", normalizeIamMembers(binding.Members, d.Get("strip_deleted_members").(bool)))
 		d.Set("role", binding.Role)
 		return nil
 	}
@@ -129,6 +139,7 @@ func iamBindingImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 		// Set the ID only to the first part so all IAM types can share the same resourceIdParserFunc.
 		d.SetId(id)
 		d.Set("role", role)
+		d.Set("strip_deleted_members", false)
 		err := resourceIdParser(d, config)
 		if err != nil {
 			return nil, err
@@ -224,7 +235,26 @@ func resourceIamBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.D
 func getResourceIamBinding(d *schema.ResourceData) *cloudresourcemanager.Binding {
 	members := d.Get("members").(*schema.Set).List()
 	return &cloudresourcemanager.Binding{
-		Members: convertStringArr(members),
+		Members: normalizeIamMembers(convertStringArr(members), false),
 		Role:    d.Get("role").(string),
 	}
 }
+
+// Removes the members of deleted principals from the binding for role, leaving
+// the bindings of other roles untouched.
+func stripDeletedIamMembersFromRole(bindings []*cloudresourcemanager.Binding, role string) []*cloudresourcemanager.Binding {
+	result := make([]*cloudresourcemanager.Binding, 0, len(bindings))
+	for _, b := range bindings {
+		if b.Role == role {
+			b = &cloudresourcemanager.Binding{
+				Role:    b.Role,
+				Members: normalizeIamMembers(b.Members, true),
+			}
+			if len(b.Members) == 0 {
+				continue
+			}
+		}
+		result = append(result, b)
+	}
+	return result
+}
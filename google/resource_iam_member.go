@@ -17,9 +17,10 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 		ForceNew: true,
 	},
 	"member": {
-		Type:     schema.TypeString,
-		Required: true,
-		ForceNew: true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: iamMemberDiffSuppress,
 	},
 	"etag": {
 		Type:     schema.TypeString,
@@ -76,7 +77,7 @@ func ResourceIamMemberWithImport(parentSpecificSchema map[string]*schema.Schema,
 
 func getResourceIamMember(d *schema.ResourceData) *cloudresourcemanager.Binding {
 	return &cloudresourcemanager.Binding{
-		Members: []string{d.Get("member").(string)},
+		Members: []string{normalizeIamMember(d.Get("member").(string))},
 		Role:    d.Get("role").(string),
 	}
 }
@@ -138,7 +139,7 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 		}
 		var member string
 		for _, m := range binding.Members {
-			if m == eMember.Members[0] {
+			if normalizeIamMember(m) == eMember.Members[0] {
 				member = m
 			}
 		}
@@ -179,7 +180,7 @@ func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 			binding := p.Bindings[bindingToRemove]
 			memberToRemove := -1
 			for pos, m := range binding.Members {
-				if m != member.Members[0] {
+				if normalizeIamMember(m) != member.Members[0] {
 					continue
 				}
 				memberToRemove = pos
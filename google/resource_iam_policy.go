@@ -17,6 +17,11 @@ var IamPolicyBaseSchema = map[string]*schema.Schema{
 		DiffSuppressFunc: jsonPolicyDiffSuppress,
 		ValidateFunc:     validateIamPolicy,
 	},
+	"strip_deleted_members": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
 	"etag": {
 		Type:     schema.TypeString,
 		Computed: true,
@@ -29,6 +34,7 @@ func iamPolicyImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 			return nil, errors.New("Import not supported for this IAM resource.")
 		}
 		config := m.(*Config)
+		d.Set("strip_deleted_members", false)
 		err := resourceIdParser(d, config)
 		if err != nil {
 			return nil, err
@@ -90,6 +96,7 @@ func ResourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 			return err
 		}
 
+		policy.Bindings = normalizeIamBindings(policy.Bindings, d.Get("strip_deleted_members").(bool))
 		d.Set("etag", policy.Etag)
 		d.Set("policy_data", marshalIamPolicy(policy))
 
@@ -138,6 +145,7 @@ func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater) error
 	if err != nil {
 		return fmt.Errorf("'policy_data' is not valid for %s: %s", updater.DescribeResource(), err)
 	}
+	policy.Bindings = normalizeIamBindings(policy.Bindings, false)
 
 	err = updater.SetResourceIamPolicy(policy)
 	if err != nil {
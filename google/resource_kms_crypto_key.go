@@ -39,6 +39,10 @@ func resourceKmsCryptoKey() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateKmsCryptoKeyRotationPeriod,
 			},
+			"self_link": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -53,6 +57,18 @@ func kmsCryptoKeyRingsEquivalent(k, old, new string, d *schema.ResourceData) boo
 	return false
 }
 
+var kmsCryptoKeyVersionSuffixRegex = regexp.MustCompile("/cryptoKeyVersions/[^/]+$")
+
+// Resources encrypted with a crypto key report the crypto key version in use,
+// while configs reference the crypto key itself. Suppress the diff unless a
+// specific version was configured.
+func compareCryptoKeyVersions(_, old, new string, _ *schema.ResourceData) bool {
+	if kmsCryptoKeyVersionSuffixRegex.MatchString(new) {
+		return old == new
+	}
+	return kmsCryptoKeyVersionSuffixRegex.ReplaceAllString(old, "") == new
+}
+
 type kmsCryptoKeyId struct {
 	KeyRingId kmsKeyRingId
 	Name      string
@@ -163,6 +179,7 @@ func resourceKmsCryptoKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("key_ring", cryptoKeyId.KeyRingId.terraformId())
 	d.Set("name", cryptoKeyId.Name)
 	d.Set("rotation_period", cryptoKey.RotationPeriod)
+	d.Set("self_link", cryptoKey.Name)
 
 	d.SetId(cryptoKeyId.cryptoKeyId())
 
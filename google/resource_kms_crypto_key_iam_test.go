@@ -179,6 +179,7 @@ resource "google_project" "test_project" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "test_project" {
@@ -222,6 +223,7 @@ resource "google_project" "test_project" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "test_project" {
@@ -274,6 +276,7 @@ resource "google_project" "test_project" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "test_project" {
@@ -69,6 +69,49 @@ func TestCryptoKeyIdParsing(t *testing.T) {
 	}
 }
 
+func TestCompareCryptoKeyVersions(t *testing.T) {
+	t.Parallel()
+
+	key := "projects/test-project/locations/us-central1/keyRings/test-key-ring/cryptoKeys/test-key-name"
+
+	cases := map[string]struct {
+		Old, New string
+		Same     bool
+	}{
+		"same key": {
+			Old:  key,
+			New:  key,
+			Same: true,
+		},
+		"key version in use": {
+			Old:  key + "/cryptoKeyVersions/1",
+			New:  key,
+			Same: true,
+		},
+		"same key version": {
+			Old:  key + "/cryptoKeyVersions/1",
+			New:  key + "/cryptoKeyVersions/1",
+			Same: true,
+		},
+		"different key version": {
+			Old:  key + "/cryptoKeyVersions/1",
+			New:  key + "/cryptoKeyVersions/2",
+			Same: false,
+		},
+		"different key": {
+			Old:  key + "/cryptoKeyVersions/1",
+			New:  key + "-2",
+			Same: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if compareCryptoKeyVersions("", tc.Old, tc.New, nil) != tc.Same {
+			t.Errorf("bad: %s, %q => %q expected same to be %t", tn, tc.Old, tc.New, tc.Same)
+		}
+	}
+}
+
 func TestCryptoKeyNextRotationCalculation(t *testing.T) {
 	t.Parallel()
 
@@ -321,6 +364,7 @@ resource "google_project" "acceptance" {
 	project_id      = "%s"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "acceptance" {
@@ -352,6 +396,7 @@ resource "google_project" "acceptance" {
 	project_id      = "%s"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "acceptance" {
@@ -383,6 +428,7 @@ resource "google_project" "acceptance" {
 	project_id      = "%s"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "acceptance" {
@@ -413,6 +459,7 @@ resource "google_project" "acceptance" {
 	project_id      = "%s"
 	org_id          = "%s"
 	billing_account = "%s"
+	deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "acceptance" {
@@ -171,6 +171,7 @@ resource "google_project" "test_project" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "test_project" {
@@ -209,6 +210,7 @@ resource "google_project" "test_project" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "test_project" {
@@ -256,6 +258,7 @@ resource "google_project" "test_project" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "test_project" {
@@ -294,6 +297,7 @@ resource "google_project" "test_project" {
   project_id      = "%s"
   org_id          = "%s"
   billing_account = "%s"
+  deletion_policy = "DELETE"
 }
 
 resource "google_project_services" "test_project" {
@@ -152,6 +152,7 @@ resource "google_project" "acceptance" {
 	project_id		= "%s"
 	org_id			= "%s"
 	billing_account	= "%s"
+	deletion_policy	= "DELETE"
 }
 
 resource "google_project_services" "acceptance" {
@@ -176,6 +177,7 @@ resource "google_project" "acceptance" {
 	project_id		= "%s"
 	org_id			= "%s"
 	billing_account	= "%s"
+	deletion_policy	= "DELETE"
 }
 
 resource "google_project_services" "acceptance" {
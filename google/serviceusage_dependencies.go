@@ -0,0 +1,236 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// serviceDependencyGraph maps a service name to the names of the services it
+// depends on, directly or transitively, as reported by Service Usage.
+type serviceDependencyGraph map[string][]string
+
+// Service dependencies are a property of the service, not of the project it
+// is enabled on, so they are cached for the lifetime of the provider.
+var serviceDependencyCache = struct {
+	sync.Mutex
+	deps map[string][]string
+}{deps: make(map[string][]string)}
+
+// getServiceDependencyGraph returns the dependencies of each of services. The
+// vendored serviceusage client predates service groups, so the dependencies
+// group of each service is listed through sendRequest.
+func getServiceDependencyGraph(pid string, services []string, config *Config) (serviceDependencyGraph, error) {
+	g := make(serviceDependencyGraph, len(services))
+	for _, s := range services {
+		deps, err := getServiceDependencies(pid, s, config)
+		if err != nil {
+			return nil, err
+		}
+		g[s] = deps
+	}
+	return g, nil
+}
+
+func getServiceDependencies(pid, service string, config *Config) ([]string, error) {
+	serviceDependencyCache.Lock()
+	deps, ok := serviceDependencyCache.deps[service]
+	serviceDependencyCache.Unlock()
+	if ok {
+		return deps, nil
+	}
+
+	deps = make([]string, 0)
+	url := fmt.Sprintf("https://serviceusage.googleapis.com/v2beta/projects/%s/services/%s/groups/dependencies/expandedMembers", pid, service)
+	pageToken := ""
+	for {
+		u, err := addQueryParams(url, map[string]string{"pageToken": pageToken})
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := sendRequest(config, "GET", u, nil)
+		if err != nil {
+			// Services without dependencies have no dependencies group
+			if isGoogleApiErrorWithCode(err, 404) {
+				break
+			}
+			return nil, fmt.Errorf("Error retrieving dependencies of service %q: %s", service, err)
+		}
+
+		members, _ := res["members"].([]interface{})
+		for _, m := range members {
+			member, ok := m.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// members are returned as "services/NAME"
+			if name, ok := member["name"].(string); ok && name != "" {
+				deps = append(deps, strings.TrimPrefix(name, "services/"))
+			}
+		}
+
+		pageToken, _ = res["nextPageToken"].(string)
+		if pageToken == "" {
+			break
+		}
+	}
+	sort.Strings(deps)
+
+	serviceDependencyCache.Lock()
+	serviceDependencyCache.deps[service] = deps
+	serviceDependencyCache.Unlock()
+
+	return deps, nil
+}
+
+// requiredBy returns the services that services depend on, excluding services
+// themselves.
+func (g serviceDependencyGraph) requiredBy(services []string) []string {
+	listed := make(map[string]struct{}, len(services))
+	for _, s := range services {
+		listed[s] = struct{}{}
+	}
+
+	required := make(map[string]struct{})
+	for _, s := range services {
+		for _, dep := range g[s] {
+			if _, ok := listed[dep]; !ok {
+				required[dep] = struct{}{}
+			}
+		}
+	}
+
+	return sortedKeys(required)
+}
+
+// dependents returns the services of candidates that depend on service.
+func (g serviceDependencyGraph) dependents(service string, candidates []string) []string {
+	dependents := make([]string, 0)
+	for _, c := range candidates {
+		if c == service {
+			continue
+		}
+		for _, dep := range g[c] {
+			if dep == service {
+				dependents = append(dependents, c)
+				break
+			}
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
+// disableOrder sorts services so that every service comes before the
+// services it depends on. Service Usage refuses to disable a service while
+// services depending on it are still enabled.
+func (g serviceDependencyGraph) disableOrder(services []string) []string {
+	remaining := make([]string, len(services))
+	copy(remaining, services)
+	sort.Strings(remaining)
+
+	ordered := make([]string, 0, len(services))
+	for len(remaining) > 0 {
+		next := make([]string, 0, len(remaining))
+		for _, s := range remaining {
+			if len(g.dependents(s, remaining)) == 0 {
+				ordered = append(ordered, s)
+			} else {
+				next = append(next, s)
+			}
+		}
+
+		// A dependency cycle can't be resolved, disable the rest as listed
+		if len(next) == len(remaining) {
+			return append(ordered, next...)
+		}
+		remaining = next
+	}
+	return ordered
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Enabling services one at a time is slow and quickly exhausts the Service
+// Usage quota when many google_project_service resources are created
+// together. batchEnable collects the services requested for a project within
+// serviceEnableBatchDelay and enables them with a single batched call.
+// Batches are per provider configuration, so that each service is enabled
+// with the credentials of the resource requesting it.
+const serviceEnableBatchDelay = 3 * time.Second
+
+type serviceEnableBatchKey struct {
+	config *Config
+	pid    string
+}
+
+type serviceEnableBatch struct {
+	services []string
+	done     chan struct{}
+	errs     map[string]error
+}
+
+var serviceEnableBatches = struct {
+	sync.Mutex
+	pending map[serviceEnableBatchKey]*serviceEnableBatch
+}{pending: make(map[serviceEnableBatchKey]*serviceEnableBatch)}
+
+func batchEnable(service, pid string, config *Config) error {
+	key := serviceEnableBatchKey{config: config, pid: pid}
+
+	serviceEnableBatches.Lock()
+	b, ok := serviceEnableBatches.pending[key]
+	if !ok {
+		b = &serviceEnableBatch{done: make(chan struct{})}
+		serviceEnableBatches.pending[key] = b
+		time.AfterFunc(serviceEnableBatchDelay, func() {
+			serviceEnableBatches.Lock()
+			delete(serviceEnableBatches.pending, key)
+			serviceEnableBatches.Unlock()
+
+			b.errs = enableServiceBatch(b.services, pid, config)
+			close(b.done)
+		})
+	}
+	if len(diffStringSlice([]string{service}, b.services)) > 0 {
+		b.services = append(b.services, service)
+	}
+	serviceEnableBatches.Unlock()
+
+	<-b.done
+	return b.errs[service]
+}
+
+// enableServiceBatch enables services and returns the error of each service
+// that couldn't be enabled. A batched call fails as a whole, so when it does
+// the services are enabled one by one to find out which of them failed.
+func enableServiceBatch(services []string, pid string, config *Config) map[string]error {
+	errs := make(map[string]error)
+	err := enableServices(services, pid, config)
+	if err == nil {
+		return errs
+	}
+	if len(services) == 1 {
+		errs[services[0]] = err
+		return errs
+	}
+
+	log.Printf("[DEBUG] Enabling services %q of project %q together failed, enabling them one by one: %s", services, pid, err)
+	for _, s := range services {
+		if err := enableServices([]string{s}, pid, config); err != nil {
+			errs[s] = err
+		}
+	}
+	return errs
+}
@@ -0,0 +1,93 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testServiceDependencyGraph = serviceDependencyGraph{
+	"container.googleapis.com": {"compute.googleapis.com", "containerregistry.googleapis.com", "oslogin.googleapis.com", "storage-api.googleapis.com"},
+	"compute.googleapis.com":   {"oslogin.googleapis.com"},
+	"dataflow.googleapis.com":  {"compute.googleapis.com", "oslogin.googleapis.com", "storage-api.googleapis.com"},
+	"iam.googleapis.com":       {},
+}
+
+func TestServiceDependencyGraphRequiredBy(t *testing.T) {
+	cases := map[string]struct {
+		Services []string
+		Expected []string
+	}{
+		"none": {
+			Services: []string{"iam.googleapis.com"},
+			Expected: []string{},
+		},
+		"transitive": {
+			Services: []string{"container.googleapis.com"},
+			Expected: []string{"compute.googleapis.com", "containerregistry.googleapis.com", "oslogin.googleapis.com", "storage-api.googleapis.com"},
+		},
+		"listed dependency": {
+			Services: []string{"container.googleapis.com", "compute.googleapis.com"},
+			Expected: []string{"containerregistry.googleapis.com", "oslogin.googleapis.com", "storage-api.googleapis.com"},
+		},
+		"shared dependency": {
+			Services: []string{"compute.googleapis.com", "dataflow.googleapis.com"},
+			Expected: []string{"oslogin.googleapis.com", "storage-api.googleapis.com"},
+		},
+	}
+
+	for tn, tc := range cases {
+		if actual := testServiceDependencyGraph.requiredBy(tc.Services); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, actual)
+		}
+	}
+}
+
+func TestServiceDependencyGraphDependents(t *testing.T) {
+	enabled := []string{"compute.googleapis.com", "container.googleapis.com", "dataflow.googleapis.com", "iam.googleapis.com", "oslogin.googleapis.com"}
+
+	cases := map[string]struct {
+		Service  string
+		Expected []string
+	}{
+		"no dependents": {
+			Service:  "iam.googleapis.com",
+			Expected: []string{},
+		},
+		"dependents": {
+			Service:  "compute.googleapis.com",
+			Expected: []string{"container.googleapis.com", "dataflow.googleapis.com"},
+		},
+		"transitive dependents": {
+			Service:  "oslogin.googleapis.com",
+			Expected: []string{"compute.googleapis.com", "container.googleapis.com", "dataflow.googleapis.com"},
+		},
+	}
+
+	for tn, tc := range cases {
+		if actual := testServiceDependencyGraph.dependents(tc.Service, enabled); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, actual)
+		}
+	}
+}
+
+func TestServiceDependencyGraphDisableOrder(t *testing.T) {
+	cases := map[string]struct {
+		Services []string
+		Expected []string
+	}{
+		"independent": {
+			Services: []string{"iam.googleapis.com", "dataflow.googleapis.com"},
+			Expected: []string{"dataflow.googleapis.com", "iam.googleapis.com"},
+		},
+		"dependencies last": {
+			Services: []string{"oslogin.googleapis.com", "compute.googleapis.com", "container.googleapis.com", "iam.googleapis.com"},
+			Expected: []string{"container.googleapis.com", "iam.googleapis.com", "compute.googleapis.com", "oslogin.googleapis.com"},
+		},
+	}
+
+	for tn, tc := range cases {
+		if actual := testServiceDependencyGraph.disableOrder(tc.Services); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, actual)
+		}
+	}
+}
@@ -115,6 +115,19 @@ func validateRFC3339Time(v interface{}, k string) (warnings []string, errors []e
 	return
 }
 
+// validateHourlyOnly checks that a time is in the HH:mm format and on the hour,
+// as required by schedules that only run at the start of an hour.
+func validateHourlyOnly(v interface{}, k string) (warnings []string, errors []error) {
+	warnings, errors = validateRFC3339Time(v, k)
+	if len(errors) > 0 {
+		return
+	}
+	if time := v.(string); time[3:] != "00" {
+		errors = append(errors, fmt.Errorf("%q (%q) must be on the hour (HH:00)", k, time))
+	}
+	return
+}
+
 func validateRFC1035Name(min, max int) schema.SchemaValidateFunc {
 	if min < 2 || max < min {
 		return func(i interface{}, k string) (s []string, errors []error) {
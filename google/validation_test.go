@@ -77,6 +77,24 @@ func TestValidateRFC3339Time(t *testing.T) {
 	}
 }
 
+func TestValidateHourlyOnly(t *testing.T) {
+	cases := []StringValidationTestCase{
+		// No errors
+		{TestName: "midnight", Value: "00:00"},
+		{TestName: "last hour", Value: "23:00"},
+
+		// With errors
+		{TestName: "not on the hour", Value: "03:30", ExpectError: true},
+		{TestName: "hour out of range", Value: "24:00", ExpectError: true},
+		{TestName: "missing colon", Value: "0100", ExpectError: true},
+	}
+
+	es := testStringValidationCases(cases, validateHourlyOnly)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate hourly times: %v", es)
+	}
+}
+
 func TestValidateRFC1035Name(t *testing.T) {
 	cases := []struct {
 		TestName    string